@@ -32,6 +32,9 @@ import (
 var (
 	errPackRequired       = errors.New("pack required")
 	errCheckpointRequired = errors.New("checkpoint required")
+	errSnapshotBroken     = errors.New("snapshot broken")
+	errElementBroken      = errors.New("element broken")
+	errUnsupportedOp      = errors.New("unsupported operation")
 )
 
 // FromChangePack converts the given Protobuf format to model format.
@@ -47,14 +50,67 @@ func FromChangePack(pbPack *api.ChangePack) (*change.Pack, error) {
 		return nil, errCheckpointRequired
 	}
 
-	return &change.Pack{
-		DocumentKey: fromDocumentKey(pbPack.DocumentKey),
-		Checkpoint:  fromCheckpoint(pbPack.Checkpoint),
-		Changes:     fromChanges(pbPack.Changes),
-	}, nil
+	changes, err := fromChanges(pbPack.Changes)
+	if err != nil {
+		return nil, err
+	}
+
+	pack := &change.Pack{
+		DocumentKey:     FromDocumentKey(pbPack.DocumentKey),
+		Checkpoint:      fromCheckpoint(pbPack.Checkpoint),
+		Changes:         changes,
+		MinSyncedTicket: fromTimeTicket(pbPack.MinSyncedTicket),
+		Partial:         pbPack.Partial,
+	}
+
+	if len(pbPack.Snapshot) > 0 {
+		snapshotPack, err := BytesToSnapshot(pbPack.Snapshot)
+		if err != nil {
+			return nil, err
+		}
+		pack.Snapshot = snapshotPack.Snapshot
+	}
+
+	return pack, nil
+}
+
+// BytesToSnapshot converts the given bytes created by SnapshotToBytes to the
+// pack with the key, the checkpoint and the local changes of the document.
+// The snapshot of the pack has the change ID and the root object.
+func BytesToSnapshot(bytes []byte) (*change.Pack, error) {
+	pbSnapshot := &api.Snapshot{}
+	if err := pbSnapshot.Unmarshal(bytes); err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	pack, err := FromChangePack(pbSnapshot.ChangePack)
+	if err != nil {
+		return nil, err
+	}
+
+	if pbSnapshot.ChangeId == nil || pbSnapshot.Root == nil {
+		log.Logger.Error(errSnapshotBroken)
+		return nil, errSnapshotBroken
+	}
+
+	elem, err := fromSnapshotElement(pbSnapshot.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	root, ok := elem.(*json.Object)
+	if !ok {
+		log.Logger.Error(errSnapshotBroken)
+		return nil, errSnapshotBroken
+	}
+
+	pack.Snapshot = change.NewSnapshot(fromChangeID(pbSnapshot.ChangeId), root)
+	return pack, nil
 }
 
-func fromDocumentKey(pbKey *api.DocumentKey) *key.Key {
+// FromDocumentKey converts the given Protobuf format to model format.
+func FromDocumentKey(pbKey *api.DocumentKey) *key.Key {
 	return &key.Key{
 		Collection: pbKey.Collection,
 		Document:   pbKey.Document,
@@ -68,17 +124,22 @@ func fromCheckpoint(pbCheckpoint *api.Checkpoint) *checkpoint.Checkpoint {
 	)
 }
 
-func fromChanges(pbChanges []*api.Change) []*change.Change {
+func fromChanges(pbChanges []*api.Change) ([]*change.Change, error) {
 	var changes []*change.Change
 	for _, pbChange := range pbChanges {
+		ops, err := FromOperations(pbChange.Operations)
+		if err != nil {
+			return nil, err
+		}
+
 		changes = append(changes, change.New(
 			fromChangeID(pbChange.Id),
 			pbChange.Message,
-			FromOperations(pbChange.Operations),
+			ops,
 		))
 	}
 
-	return changes
+	return changes, nil
 }
 
 func fromChangeID(id *api.ChangeID) *change.ID {
@@ -93,36 +154,46 @@ func fromChangeID(id *api.ChangeID) *change.ID {
 func FromDocumentKeys(pbKeys []*api.DocumentKey) []*key.Key {
 	var keys []*key.Key
 	for _, pbKey := range pbKeys {
-		keys = append(keys, fromDocumentKey(pbKey))
+		keys = append(keys, FromDocumentKey(pbKey))
 	}
 	return keys
 }
 
 // FromOperations converts the given Protobuf format to model format.
-func FromOperations(pbOps []*api.Operation) []operation.Operation {
+func FromOperations(pbOps []*api.Operation) ([]operation.Operation, error) {
 	var ops []operation.Operation
 
 	for _, pbOp := range pbOps {
 		var op operation.Operation
 		switch decoded := pbOp.Body.(type) {
 		case *api.Operation_Set_:
+			elem, err := fromElement(decoded.Set.Value)
+			if err != nil {
+				return nil, err
+			}
 			op = operation.NewSet(
 				fromTimeTicket(decoded.Set.ParentCreatedAt),
 				decoded.Set.Key,
-				fromElement(decoded.Set.Value),
+				elem,
+				fromObservedCreatedAts(decoded.Set.ObservedCreatedAts),
 				fromTimeTicket(decoded.Set.ExecutedAt),
 			)
 		case *api.Operation_Add_:
+			elem, err := fromElement(decoded.Add.Value)
+			if err != nil {
+				return nil, err
+			}
 			op = operation.NewAdd(
 				fromTimeTicket(decoded.Add.ParentCreatedAt),
 				fromTimeTicket(decoded.Add.PrevCreatedAt),
-				fromElement(decoded.Add.Value),
+				elem,
 				fromTimeTicket(decoded.Add.ExecutedAt),
 			)
 		case *api.Operation_Remove_:
 			op = operation.NewRemove(
 				fromTimeTicket(decoded.Remove.ParentCreatedAt),
 				fromTimeTicket(decoded.Remove.CreatedAt),
+				fromObservedCreatedAts(decoded.Remove.ObservedCreatedAts),
 				fromTimeTicket(decoded.Remove.ExecutedAt),
 			)
 		case *api.Operation_Edit_:
@@ -141,13 +212,40 @@ func FromOperations(pbOps []*api.Operation) []operation.Operation {
 				fromTextNodePos(decoded.Select.To),
 				fromTimeTicket(decoded.Select.ExecutedAt),
 			)
+		case *api.Operation_Increase_:
+			elem, err := fromElement(decoded.Increase.Value)
+			if err != nil {
+				return nil, err
+			}
+			op = operation.NewIncrease(
+				fromTimeTicket(decoded.Increase.ParentCreatedAt),
+				elem,
+				fromTimeTicket(decoded.Increase.ExecutedAt),
+			)
+		case *api.Operation_Move_:
+			op = operation.NewMove(
+				fromTimeTicket(decoded.Move.ParentCreatedAt),
+				fromTimeTicket(decoded.Move.PrevCreatedAt),
+				fromTimeTicket(decoded.Move.CreatedAt),
+				fromTimeTicket(decoded.Move.ExecutedAt),
+			)
+		case *api.Operation_Style_:
+			op = operation.NewStyle(
+				fromTimeTicket(decoded.Style.ParentCreatedAt),
+				fromTextNodePos(decoded.Style.From),
+				fromTextNodePos(decoded.Style.To),
+				fromCreatedAtMapByActor(decoded.Style.CreatedAtMapByActor),
+				decoded.Style.Attributes,
+				fromTimeTicket(decoded.Style.ExecutedAt),
+			)
 		default:
-			panic("unsupported operation")
+			log.Logger.Error(errUnsupportedOp)
+			return nil, errUnsupportedOp
 		}
 		ops = append(ops, op)
 	}
 
-	return ops
+	return ops, nil
 }
 
 func fromCreatedAtMapByActor(
@@ -167,7 +265,28 @@ func fromTextNodePos(pbPos *api.TextNodePos) *json.TextNodePos {
 	)
 }
 
+func fromObservedCreatedAts(pbTickets *api.TimeTickets) []*time.Ticket {
+	if pbTickets == nil {
+		return nil
+	}
+
+	tickets := []*time.Ticket{}
+	return append(tickets, fromTimeTickets(pbTickets.Tickets)...)
+}
+
+func fromTimeTickets(pbTickets []*api.TimeTicket) []*time.Ticket {
+	var tickets []*time.Ticket
+	for _, pbTicket := range pbTickets {
+		tickets = append(tickets, fromTimeTicket(pbTicket))
+	}
+	return tickets
+}
+
 func fromTimeTicket(pbTicket *api.TimeTicket) *time.Ticket {
+	if pbTicket == nil {
+		return nil
+	}
+
 	return time.NewTicket(
 		pbTicket.Lamport,
 		pbTicket.Delimiter,
@@ -175,59 +294,187 @@ func fromTimeTicket(pbTicket *api.TimeTicket) *time.Ticket {
 	)
 }
 
-func fromElement(pbElement *api.JSONElement) json.Element {
+func fromElement(pbElement *api.JSONElement) (json.Element, error) {
+	if pbElement == nil {
+		log.Logger.Error(errElementBroken)
+		return nil, errElementBroken
+	}
+	if pbElement.Subtree != nil {
+		return fromSnapshotElement(pbElement.Subtree)
+	}
+
 	switch pbElement.Type {
 	case api.ValueType_JSON_OBJECT:
 		return json.NewObject(
 			json.NewRHT(),
 			fromTimeTicket(pbElement.CreatedAt),
-		)
+		), nil
 	case api.ValueType_JSON_ARRAY:
 		return json.NewArray(
 			json.NewRGA(),
 			fromTimeTicket(pbElement.CreatedAt),
-		)
+		), nil
+	case api.ValueType_NULL:
+		return json.NewPrimitive(
+			nil,
+			fromTimeTicket(pbElement.CreatedAt),
+		), nil
 	case api.ValueType_BOOLEAN:
 		return json.NewPrimitive(
 			json.ValueFromBytes(json.Boolean, pbElement.Value),
 			fromTimeTicket(pbElement.CreatedAt),
-		)
+		), nil
 	case api.ValueType_INTEGER:
 		return json.NewPrimitive(
 			json.ValueFromBytes(json.Integer, pbElement.Value),
 			fromTimeTicket(pbElement.CreatedAt),
-		)
+		), nil
 	case api.ValueType_LONG:
 		return json.NewPrimitive(
 			json.ValueFromBytes(json.Long, pbElement.Value),
 			fromTimeTicket(pbElement.CreatedAt),
-		)
+		), nil
 	case api.ValueType_DOUBLE:
 		return json.NewPrimitive(
 			json.ValueFromBytes(json.Double, pbElement.Value),
 			fromTimeTicket(pbElement.CreatedAt),
-		)
+		), nil
 	case api.ValueType_STRING:
 		return json.NewPrimitive(
 			json.ValueFromBytes(json.String, pbElement.Value),
 			fromTimeTicket(pbElement.CreatedAt),
-		)
+		), nil
 	case api.ValueType_BYTES:
 		return json.NewPrimitive(
 			json.ValueFromBytes(json.Bytes, pbElement.Value),
 			fromTimeTicket(pbElement.CreatedAt),
-		)
+		), nil
 	case api.ValueType_DATE:
 		return json.NewPrimitive(
 			json.ValueFromBytes(json.Date, pbElement.Value),
 			fromTimeTicket(pbElement.CreatedAt),
-		)
+		), nil
 	case api.ValueType_TEXT:
 		return json.NewText(
 			json.NewRGATreeSplit(),
 			fromTimeTicket(pbElement.CreatedAt),
-		)
+		), nil
+	case api.ValueType_INTEGER_CNT:
+		return json.NewCounter(
+			json.ValueFromBytes(json.Integer, pbElement.Value),
+			fromTimeTicket(pbElement.CreatedAt),
+		), nil
+	case api.ValueType_LONG_CNT:
+		return json.NewCounter(
+			json.ValueFromBytes(json.Long, pbElement.Value),
+			fromTimeTicket(pbElement.CreatedAt),
+		), nil
+	case api.ValueType_DOUBLE_CNT:
+		return json.NewCounter(
+			json.ValueFromBytes(json.Double, pbElement.Value),
+			fromTimeTicket(pbElement.CreatedAt),
+		), nil
+	}
+
+	log.Logger.Error(errElementBroken)
+	return nil, errElementBroken
+}
+
+func fromSnapshotElement(pbElement *api.SnapshotElement) (json.Element, error) {
+	if pbElement == nil {
+		log.Logger.Error(errElementBroken)
+		return nil, errElementBroken
 	}
 
-	panic("fail to decode element")
+	switch decoded := pbElement.Body.(type) {
+	case *api.SnapshotElement_Object_:
+		members := json.NewRHT()
+		for _, pbNode := range decoded.Object.Nodes {
+			elem, err := fromSnapshotElement(pbNode.Element)
+			if err != nil {
+				return nil, err
+			}
+			members.SetInternal(pbNode.Key, elem)
+		}
+
+		obj := json.NewObject(members, fromTimeTicket(decoded.Object.CreatedAt))
+		obj.Delete(fromTimeTicket(decoded.Object.DeletedAt))
+		return obj, nil
+	case *api.SnapshotElement_Array_:
+		elements := json.NewRGA()
+		for _, pbNode := range decoded.Array.Nodes {
+			if pbNode.Element == nil {
+				elements.AddMovedAway(
+					fromTimeTicket(pbNode.PositionedAt),
+					fromTimeTicket(pbNode.MovedAwayAt),
+				)
+				continue
+			}
+
+			elem, err := fromSnapshotElement(pbNode.Element)
+			if err != nil {
+				return nil, err
+			}
+			elements.AddWithMovedAt(elem, fromTimeTicket(pbNode.MovedAt))
+		}
+
+		array := json.NewArray(elements, fromTimeTicket(decoded.Array.CreatedAt))
+		array.Delete(fromTimeTicket(decoded.Array.DeletedAt))
+		return array, nil
+	case *api.SnapshotElement_Text_:
+		rgaTreeSplit := json.NewRGATreeSplit()
+
+		current := rgaTreeSplit.InitialHead()
+		for _, pbNode := range decoded.Text.Nodes {
+			node := json.NewTextNode(fromTextNodeID(pbNode.Id), pbNode.Value)
+			node.Delete(fromTimeTicket(pbNode.DeletedAt))
+			for key, pbAttr := range pbNode.Attributes {
+				node.SetAttr(key, pbAttr.Value, fromTimeTicket(pbAttr.UpdatedAt))
+			}
+
+			current = rgaTreeSplit.InsertAfter(current, node)
+			if pbNode.InsPrevId != nil {
+				current.SetInsPrev(rgaTreeSplit.FindTextNode(fromTextNodeID(pbNode.InsPrevId)))
+			}
+		}
+
+		for _, pbPurgedNode := range decoded.Text.PurgedNodes {
+			rgaTreeSplit.AddPurgedNode(json.NewPurgedTextNode(
+				fromTextNodeID(pbPurgedNode.Id),
+				fromTextNodePos(pbPurgedNode.FallbackPos),
+			))
+		}
+
+		text := json.NewText(rgaTreeSplit, fromTimeTicket(decoded.Text.CreatedAt))
+		for _, pbSelection := range decoded.Text.Selections {
+			text.Select(
+				fromTextNodePos(pbSelection.From),
+				fromTextNodePos(pbSelection.To),
+				fromTimeTicket(pbSelection.UpdatedAt),
+			)
+		}
+		text.Delete(fromTimeTicket(decoded.Text.DeletedAt))
+		return text, nil
+	case *api.SnapshotElement_Primitive:
+		elem, err := fromElement(decoded.Primitive)
+		if err != nil {
+			return nil, err
+		}
+		elem.Delete(fromTimeTicket(decoded.Primitive.DeletedAt))
+		return elem, nil
+	case *api.SnapshotElement_Counter:
+		elem, err := fromElement(decoded.Counter)
+		if err != nil {
+			return nil, err
+		}
+		elem.Delete(fromTimeTicket(decoded.Counter.DeletedAt))
+		return elem, nil
+	}
+
+	log.Logger.Error(errElementBroken)
+	return nil, errElementBroken
+}
+
+func fromTextNodeID(pbID *api.TextNodeID) *json.TextNodeID {
+	return json.NewTextNodeID(fromTimeTicket(pbID.CreatedAt), int(pbID.Offset))
 }
@@ -24,18 +24,78 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/operation"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/log"
 )
 
 // ToChangePack converts the given model format to Protobuf format.
-func ToChangePack(pack *change.Pack) *api.ChangePack {
+func ToChangePack(pack *change.Pack) (*api.ChangePack, error) {
+	snapshot, err := toSnapshotBytes(pack)
+	if err != nil {
+		return nil, err
+	}
+
 	return &api.ChangePack{
-		DocumentKey: toDocumentKey(pack.DocumentKey),
-		Checkpoint:  toCheckpoint(pack.Checkpoint),
-		Changes:     toChanges(pack.Changes),
+		DocumentKey:     ToDocumentKey(pack.DocumentKey),
+		Checkpoint:      toCheckpoint(pack.Checkpoint),
+		Changes:         toChanges(pack.Changes),
+		MinSyncedTicket: toTimeTicket(pack.MinSyncedTicket),
+		Snapshot:        snapshot,
+		Partial:         pack.Partial,
+	}, nil
+}
+
+// SnapshotToBytes converts the given pack created by Document.Snapshot to
+// bytes. The pack contains the key, the checkpoint and the local changes of
+// the document.
+func SnapshotToBytes(pack *change.Pack) ([]byte, error) {
+	snapshot, err := toSnapshot(pack)
+	if err != nil {
+		return nil, err
 	}
+
+	bytes, err := snapshot.Marshal()
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	return bytes, nil
+}
+
+func toSnapshot(pack *change.Pack) (*api.Snapshot, error) {
+	changePack, err := ToChangePack(change.NewPack(
+		pack.DocumentKey,
+		pack.Checkpoint,
+		pack.Changes,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Snapshot{
+		ChangePack: changePack,
+		ChangeId:   toChangeID(pack.Snapshot.ID),
+		Root:       toSnapshotElement(pack.Snapshot.Root),
+	}, nil
+}
+
+// toSnapshotBytes converts the snapshot of the given pack to bytes. The
+// changes of the pack are not included because they are sent after the
+// snapshot.
+func toSnapshotBytes(pack *change.Pack) ([]byte, error) {
+	if !pack.HasSnapshot() {
+		return nil, nil
+	}
+
+	return SnapshotToBytes(&change.Pack{
+		DocumentKey: pack.DocumentKey,
+		Checkpoint:  pack.Checkpoint,
+		Snapshot:    pack.Snapshot,
+	})
 }
 
-func toDocumentKey(key *key.Key) *api.DocumentKey {
+// ToDocumentKey converts the given model format to Protobuf format.
+func ToDocumentKey(key *key.Key) *api.DocumentKey {
 	return &api.DocumentKey{
 		Collection: key.Collection,
 		Document:   key.Document,
@@ -74,11 +134,33 @@ func toChangeID(id *change.ID) *api.ChangeID {
 func ToDocumentKeys(keys ...*key.Key) []*api.DocumentKey {
 	var pbKeys []*api.DocumentKey
 	for _, k := range keys {
-		pbKeys = append(pbKeys, toDocumentKey(k))
+		pbKeys = append(pbKeys, ToDocumentKey(k))
 	}
 	return pbKeys
 }
 
+// ToChangeSummaries converts the given changes to the Protobuf format with the
+// human-readable summaries of their operations.
+func ToChangeSummaries(changes []*change.Change) []*api.ChangeSummary {
+	var summaries []*api.ChangeSummary
+	for _, c := range changes {
+		var ops []string
+		for _, op := range c.Operations() {
+			ops = append(ops, operation.Summarize(op))
+		}
+
+		summaries = append(summaries, &api.ChangeSummary{
+			ServerSeq:  c.ServerSeq(),
+			ClientSeq:  c.ClientSeq(),
+			Lamport:    c.ID().Lamport(),
+			ActorId:    c.ID().Actor().String(),
+			Message:    c.Message(),
+			Operations: ops,
+		})
+	}
+	return summaries
+}
+
 // ToOperations converts the given model format to Protobuf format.
 func ToOperations(operations []operation.Operation) []*api.Operation {
 	var pbOperations []*api.Operation
@@ -89,10 +171,11 @@ func ToOperations(operations []operation.Operation) []*api.Operation {
 		case *operation.Set:
 			pbOperation.Body = &api.Operation_Set_{
 				Set: &api.Operation_Set{
-					ParentCreatedAt: toTimeTicket(op.ParentCreatedAt()),
-					Key:             op.Key(),
-					Value:           toJSONElement(op.Value()),
-					ExecutedAt:      toTimeTicket(op.ExecutedAt()),
+					ParentCreatedAt:    toTimeTicket(op.ParentCreatedAt()),
+					Key:                op.Key(),
+					Value:              toJSONElement(op.Value()),
+					ExecutedAt:         toTimeTicket(op.ExecutedAt()),
+					ObservedCreatedAts: toObservedCreatedAts(op.ObservedCreatedAts()),
 				},
 			}
 		case *operation.Add:
@@ -107,9 +190,10 @@ func ToOperations(operations []operation.Operation) []*api.Operation {
 		case *operation.Remove:
 			pbOperation.Body = &api.Operation_Remove_{
 				Remove: &api.Operation_Remove{
-					ParentCreatedAt: toTimeTicket(op.ParentCreatedAt()),
-					CreatedAt:       toTimeTicket(op.CreatedAt()),
-					ExecutedAt:      toTimeTicket(op.ExecutedAt()),
+					ParentCreatedAt:    toTimeTicket(op.ParentCreatedAt()),
+					CreatedAt:          toTimeTicket(op.CreatedAt()),
+					ExecutedAt:         toTimeTicket(op.ExecutedAt()),
+					ObservedCreatedAts: toObservedCreatedAts(op.ObservedCreatedAts()),
 				},
 			}
 		case *operation.Edit:
@@ -132,6 +216,34 @@ func ToOperations(operations []operation.Operation) []*api.Operation {
 					ExecutedAt:      toTimeTicket(op.ExecutedAt()),
 				},
 			}
+		case *operation.Increase:
+			pbOperation.Body = &api.Operation_Increase_{
+				Increase: &api.Operation_Increase{
+					ParentCreatedAt: toTimeTicket(op.ParentCreatedAt()),
+					Value:           toJSONElement(op.Value()),
+					ExecutedAt:      toTimeTicket(op.ExecutedAt()),
+				},
+			}
+		case *operation.Move:
+			pbOperation.Body = &api.Operation_Move_{
+				Move: &api.Operation_Move{
+					ParentCreatedAt: toTimeTicket(op.ParentCreatedAt()),
+					PrevCreatedAt:   toTimeTicket(op.PrevCreatedAt()),
+					CreatedAt:       toTimeTicket(op.CreatedAt()),
+					ExecutedAt:      toTimeTicket(op.ExecutedAt()),
+				},
+			}
+		case *operation.Style:
+			pbOperation.Body = &api.Operation_Style_{
+				Style: &api.Operation_Style{
+					ParentCreatedAt:     toTimeTicket(op.ParentCreatedAt()),
+					From:                toTextNodePos(op.From()),
+					To:                  toTextNodePos(op.To()),
+					CreatedAtMapByActor: toCreatedAtMapByActor(op.CreatedAtMapByActor()),
+					Attributes:          op.Attributes(),
+					ExecutedAt:          toTimeTicket(op.ExecutedAt()),
+				},
+			}
 		default:
 			panic("unsupported operation")
 		}
@@ -144,17 +256,30 @@ func ToOperations(operations []operation.Operation) []*api.Operation {
 func toJSONElement(elem json.Element) *api.JSONElement {
 	switch elem := elem.(type) {
 	case *json.Object:
-		return &api.JSONElement{
+		pbElement := &api.JSONElement{
 			Type:      api.ValueType_JSON_OBJECT,
 			CreatedAt: toTimeTicket(elem.CreatedAt()),
 		}
+		if len(elem.RHTNodes()) > 0 {
+			pbElement.Subtree = toSnapshotElement(elem)
+		}
+		return pbElement
 	case *json.Array:
-		return &api.JSONElement{
+		pbElement := &api.JSONElement{
 			Type:      api.ValueType_JSON_ARRAY,
 			CreatedAt: toTimeTicket(elem.CreatedAt()),
 		}
+		if len(elem.Nodes()) > 0 {
+			pbElement.Subtree = toSnapshotElement(elem)
+		}
+		return pbElement
 	case *json.Primitive:
 		switch elem.ValueType() {
+		case json.Null:
+			return &api.JSONElement{
+				Type:      api.ValueType_NULL,
+				CreatedAt: toTimeTicket(elem.CreatedAt()),
+			}
 		case json.Boolean:
 			return &api.JSONElement{
 				Type:      api.ValueType_BOOLEAN,
@@ -199,10 +324,35 @@ func toJSONElement(elem json.Element) *api.JSONElement {
 			}
 		}
 	case *json.Text:
-		return &api.JSONElement{
+		pbElement := &api.JSONElement{
 			Type:      api.ValueType_TEXT,
 			CreatedAt: toTimeTicket(elem.CreatedAt()),
 		}
+		if len(elem.TextNodes()) > 0 {
+			pbElement.Subtree = toSnapshotElement(elem)
+		}
+		return pbElement
+	case *json.Counter:
+		switch elem.ValueType() {
+		case json.Integer:
+			return &api.JSONElement{
+				Type:      api.ValueType_INTEGER_CNT,
+				CreatedAt: toTimeTicket(elem.CreatedAt()),
+				Value:     elem.Bytes(),
+			}
+		case json.Long:
+			return &api.JSONElement{
+				Type:      api.ValueType_LONG_CNT,
+				CreatedAt: toTimeTicket(elem.CreatedAt()),
+				Value:     elem.Bytes(),
+			}
+		case json.Double:
+			return &api.JSONElement{
+				Type:      api.ValueType_DOUBLE_CNT,
+				CreatedAt: toTimeTicket(elem.CreatedAt()),
+				Value:     elem.Bytes(),
+			}
+		}
 	}
 	panic("fail to encode JSONElement to protobuf")
 }
@@ -225,10 +375,146 @@ func toCreatedAtMapByActor(
 	return pbCreatedAtMapByActor
 }
 
+// toObservedCreatedAts converts the given observed creation times keeping nil
+// apart from empty, because nil means that the last writer wins.
+func toObservedCreatedAts(tickets []*time.Ticket) *api.TimeTickets {
+	if tickets == nil {
+		return nil
+	}
+
+	return &api.TimeTickets{Tickets: toTimeTickets(tickets)}
+}
+
+func toTimeTickets(tickets []*time.Ticket) []*api.TimeTicket {
+	var pbTickets []*api.TimeTicket
+	for _, ticket := range tickets {
+		pbTickets = append(pbTickets, toTimeTicket(ticket))
+	}
+	return pbTickets
+}
+
 func toTimeTicket(ticket *time.Ticket) *api.TimeTicket {
+	if ticket == nil {
+		return nil
+	}
+
 	return &api.TimeTicket{
 		Lamport:   ticket.Lamport(),
 		Delimiter: ticket.Delimiter(),
 		ActorId:   ticket.ActorIDHex(),
 	}
 }
+
+func toSnapshotElement(elem json.Element) *api.SnapshotElement {
+	switch elem := elem.(type) {
+	case *json.Object:
+		var pbNodes []*api.RHTNode
+		for _, node := range elem.RHTNodes() {
+			pbNodes = append(pbNodes, &api.RHTNode{
+				Key:     node.Key(),
+				Element: toSnapshotElement(node.Element()),
+			})
+		}
+		return &api.SnapshotElement{
+			Body: &api.SnapshotElement_Object_{Object: &api.SnapshotElement_Object{
+				Nodes:     pbNodes,
+				CreatedAt: toTimeTicket(elem.CreatedAt()),
+				DeletedAt: toTimeTicket(elem.DeletedAt()),
+			}},
+		}
+	case *json.Array:
+		var pbNodes []*api.RGANode
+		for _, node := range elem.AllNodes() {
+			if node.Element() == nil {
+				pbNodes = append(pbNodes, &api.RGANode{
+					PositionedAt: toTimeTicket(node.PositionedAt()),
+					MovedAwayAt:  toTimeTicket(node.MovedAwayAt()),
+				})
+				continue
+			}
+
+			pbNodes = append(pbNodes, &api.RGANode{
+				Element: toSnapshotElement(node.Element()),
+				MovedAt: toTimeTicket(node.MovedAt()),
+			})
+		}
+		return &api.SnapshotElement{
+			Body: &api.SnapshotElement_Array_{Array: &api.SnapshotElement_Array{
+				Nodes:     pbNodes,
+				CreatedAt: toTimeTicket(elem.CreatedAt()),
+				DeletedAt: toTimeTicket(elem.DeletedAt()),
+			}},
+		}
+	case *json.Text:
+		var pbNodes []*api.TextNode
+		for _, node := range elem.TextNodes() {
+			pbAttrs := make(map[string]*api.TextNodeAttr)
+			for key, attr := range node.Attrs() {
+				pbAttrs[key] = &api.TextNodeAttr{
+					Value:     attr.Value(),
+					UpdatedAt: toTimeTicket(attr.UpdatedAt()),
+				}
+			}
+
+			pbNodes = append(pbNodes, &api.TextNode{
+				Id:         toTextNodeID(node.ID()),
+				Value:      node.String(),
+				DeletedAt:  toTimeTicket(node.DeletedAt()),
+				InsPrevId:  toTextNodeID(node.InsPrevID()),
+				Attributes: pbAttrs,
+			})
+		}
+
+		pbSelections := make(map[string]*api.TextSelection)
+		for actor, selection := range elem.SelectionMap() {
+			pbSelections[actor] = &api.TextSelection{
+				From:      toTextNodePos(selection.From()),
+				To:        toTextNodePos(selection.To()),
+				UpdatedAt: toTimeTicket(selection.UpdatedAt()),
+			}
+		}
+
+		var pbPurgedNodes []*api.PurgedTextNode
+		for _, purgedNode := range elem.PurgedNodes() {
+			pbPurgedNodes = append(pbPurgedNodes, &api.PurgedTextNode{
+				Id:          toTextNodeID(purgedNode.ID()),
+				FallbackPos: toTextNodePos(purgedNode.FallbackPos()),
+			})
+		}
+
+		return &api.SnapshotElement{
+			Body: &api.SnapshotElement_Text_{Text: &api.SnapshotElement_Text{
+				Nodes:       pbNodes,
+				Selections:  pbSelections,
+				CreatedAt:   toTimeTicket(elem.CreatedAt()),
+				DeletedAt:   toTimeTicket(elem.DeletedAt()),
+				PurgedNodes: pbPurgedNodes,
+			}},
+		}
+	case *json.Primitive:
+		pbElement := toJSONElement(elem)
+		pbElement.DeletedAt = toTimeTicket(elem.DeletedAt())
+		return &api.SnapshotElement{
+			Body: &api.SnapshotElement_Primitive{Primitive: pbElement},
+		}
+	case *json.Counter:
+		pbElement := toJSONElement(elem)
+		pbElement.DeletedAt = toTimeTicket(elem.DeletedAt())
+		return &api.SnapshotElement{
+			Body: &api.SnapshotElement_Counter{Counter: pbElement},
+		}
+	}
+
+	panic("fail to encode SnapshotElement to protobuf")
+}
+
+func toTextNodeID(id *json.TextNodeID) *api.TextNodeID {
+	if id == nil {
+		return nil
+	}
+
+	return &api.TextNodeID{
+		CreatedAt: toTimeTicket(id.CreatedAt()),
+		Offset:    int32(id.Offset()),
+	}
+}
@@ -19,9 +19,13 @@ package client
 import (
 	"context"
 	"errors"
+	"strings"
+	gotime "time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/api/converter"
@@ -31,6 +35,11 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/log"
 )
 
+// SaveInterval is the minimum interval between the saves of a document by its
+// local changes. The local changes made within the interval are saved by the
+// next local change after it, Sync or Close.
+const SaveInterval = gotime.Second
+
 type status int
 
 const (
@@ -41,6 +50,8 @@ const (
 var (
 	errClientNotActivated  = errors.New("client is not activated")
 	errDocumentNotAttached = errors.New("document is not attached")
+
+	errDocumentHasLocalChanges = errors.New("document to resume has local changes")
 )
 
 // Client is a normal client that can communicate with the agent.
@@ -54,6 +65,14 @@ type Client struct {
 	key          string
 	status       status
 	attachedDocs map[string]*document.Document
+
+	// store keeps the attached documents to resume them after restart. It is
+	// nil if the documents are only kept in memory.
+	store Store
+
+	// savedAtMapByKey holds the last time each attached document was saved
+	// in the store.
+	savedAtMapByKey map[string]gotime.Time
 }
 
 // NewClient creates an instance of Client.
@@ -75,26 +94,67 @@ func NewClient(rpcAddr string, opts ...string) (*Client, error) {
 	client := api.NewYorkieClient(conn)
 
 	return &Client{
-		conn:         conn,
-		client:       client,
-		key:          k,
-		status:       deactivated,
-		attachedDocs: make(map[string]*document.Document),
+		conn:            conn,
+		client:          client,
+		key:             k,
+		status:          deactivated,
+		attachedDocs:    make(map[string]*document.Document),
+		savedAtMapByKey: make(map[string]gotime.Time),
 	}, nil
 }
 
-// Close closes all resources of this client.
+// NewClientWithStore creates an instance of Client which keeps the attached
+// documents in the given store. The documents are saved by local changes at
+// most once per SaveInterval and resumed by Attach, so the same key should be
+// given after restart.
+func NewClientWithStore(rpcAddr, clientKey string, store Store) (*Client, error) {
+	cli, err := NewClient(rpcAddr, clientKey)
+	if err != nil {
+		return nil, err
+	}
+
+	cli.store = store
+	return cli, nil
+}
+
+// Close closes all resources of this client. The attached documents are
+// saved in the store if this client has one. The connection is closed even if
+// saving or deactivating fails, and the errors are combined.
 func (c *Client) Close() error {
+	var errs []error
+	for _, doc := range c.attachedDocs {
+		if err := c.save(doc); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	if err := c.Deactivate(context.Background()); err != nil {
-		return err
+		errs = append(errs, err)
 	}
 
 	if err := c.conn.Close(); err != nil {
 		log.Logger.Error(err)
-		return err
+		errs = append(errs, err)
 	}
 
-	return nil
+	return combineErrors(errs)
+}
+
+// combineErrors returns an error having the messages of the given errors. It
+// returns the error itself if there is only one.
+func combineErrors(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	if len(errs) == 1 {
+		return errs[0]
+	}
+
+	var messages []string
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	return errors.New(strings.Join(messages, "; "))
 }
 
 // Activate activates this client. That is, it register itself to the agent
@@ -141,16 +201,36 @@ func (c *Client) Deactivate(ctx context.Context) error {
 
 // Attach attaches the given document to this client. It tells the agent that
 // this client will synchronize the given document.
+//
+// If the store of this client has the document, the given document is
+// restored from it with the local changes and synchronized. If the agent is
+// not reachable while synchronizing, the document is attached anyway and the
+// local changes are pushed by the next Sync.
 func (c *Client) Attach(ctx context.Context, doc *document.Document) error {
 	if c.status != activated {
 		return errClientNotActivated
 	}
 
+	if c.store != nil {
+		resumed, err := c.resume(ctx, doc)
+		if err != nil {
+			return err
+		}
+		if resumed {
+			return nil
+		}
+	}
+
 	doc.SetActor(c.id)
 
+	pbPack, err := converter.ToChangePack(doc.CreateChangePack())
+	if err != nil {
+		return err
+	}
+
 	res, err := c.client.AttachDocument(ctx, &api.AttachDocumentRequest{
 		ClientId:   c.id.String(),
-		ChangePack: converter.ToChangePack(doc.CreateChangePack()),
+		ChangePack: pbPack,
 	})
 	if err != nil {
 		log.Logger.Error(err)
@@ -168,9 +248,60 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document) error {
 	}
 
 	doc.UpdateState(document.Attached)
+	doc.SetLocalChangeHandler(func() error {
+		return c.saveLocalChanges(doc)
+	})
 	c.attachedDocs[doc.Key().BSONKey()] = doc
 
-	return nil
+	if pack.Partial || doc.HasLocalChanges() {
+		return c.sync(ctx, doc.Key())
+	}
+
+	return c.save(doc)
+}
+
+// resume restores the given document from the store and synchronizes it. It
+// returns false if the store does not have the document or the agent does not
+// know that this client has attached it, e.g. the key of this client has
+// been changed.
+func (c *Client) resume(ctx context.Context, doc *document.Document) (bool, error) {
+	snapshot, err := c.store.Load(doc.Key())
+	if err == ErrSnapshotNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if doc.HasLocalChanges() {
+		return false, errDocumentHasLocalChanges
+	}
+	pack, err := converter.BytesToSnapshot(snapshot)
+	if err != nil {
+		return false, err
+	}
+	doc.Restore(pack)
+
+	doc.SetActor(c.id)
+	doc.UpdateState(document.Attached)
+	doc.SetLocalChangeHandler(func() error {
+		return c.saveLocalChanges(doc)
+	})
+	c.attachedDocs[doc.Key().BSONKey()] = doc
+
+	err = c.sync(ctx, doc.Key())
+	if err == nil || grpcstatus.Code(err) == codes.Unavailable {
+		return true, nil
+	}
+
+	doc.UpdateState(document.Detached)
+	doc.SetLocalChangeHandler(nil)
+	delete(c.attachedDocs, doc.Key().BSONKey())
+	delete(c.savedAtMapByKey, doc.Key().BSONKey())
+	if grpcstatus.Code(err) == codes.FailedPrecondition {
+		return false, nil
+	}
+	return false, err
 }
 
 // Detach detaches the given document from this client. It tells the
@@ -188,9 +319,22 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document) error {
 		return errDocumentNotAttached
 	}
 
+	// NOTE: The agent may not accept all the local changes in a pack. They
+	// are pushed before detaching so that none of them are left behind.
+	if doc.HasLocalChanges() {
+		if err := c.sync(ctx, doc.Key()); err != nil {
+			return err
+		}
+	}
+
+	pbPack, err := converter.ToChangePack(doc.CreateChangePack())
+	if err != nil {
+		return err
+	}
+
 	res, err := c.client.DetachDocument(ctx, &api.DetachDocumentRequest{
 		ClientId:   c.id.String(),
-		ChangePack: converter.ToChangePack(doc.CreateChangePack()),
+		ChangePack: pbPack,
 	})
 	if err != nil {
 		log.Logger.Error(err)
@@ -208,7 +352,15 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document) error {
 	}
 
 	doc.UpdateState(document.Detached)
+	doc.SetLocalChangeHandler(nil)
 	delete(c.attachedDocs, doc.Key().BSONKey())
+	delete(c.savedAtMapByKey, doc.Key().BSONKey())
+
+	if c.store != nil {
+		if err := c.store.Delete(doc.Key()); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -278,6 +430,61 @@ func (c *Client) Watch(ctx context.Context, docs ...*document.Document) <-chan W
 	return rch
 }
 
+// GetDocument returns the current JSON of the document of the given key and
+// the server seq of it. It does not require this client to be activated.
+func (c *Client) GetDocument(ctx context.Context, k *key.Key) (string, uint64, error) {
+	res, err := c.client.GetDocument(ctx, &api.GetDocumentRequest{
+		DocumentKey: converter.ToDocumentKey(k),
+	})
+	if err != nil {
+		log.Logger.Error(err)
+		return "", 0, err
+	}
+
+	return res.Json, res.ServerSeq, nil
+}
+
+// GetDocumentAt returns the JSON of the document of the given key as it was
+// at the given server seq. It does not require this client to be activated.
+func (c *Client) GetDocumentAt(ctx context.Context, k *key.Key, serverSeq uint64) (string, error) {
+	res, err := c.client.GetDocumentAt(ctx, &api.GetDocumentAtRequest{
+		DocumentKey: converter.ToDocumentKey(k),
+		ServerSeq:   serverSeq,
+	})
+	if err != nil {
+		log.Logger.Error(err)
+		return "", err
+	}
+
+	return res.Json, nil
+}
+
+// ListChanges returns the summaries of at most the given number of changes of
+// the document of the given key from the given server seq. If the given actor
+// ID is not empty, only the changes made by the actor are returned. It also
+// returns the server seq to continue from, which is 0 if there are no more
+// changes. It does not require this client to be activated.
+func (c *Client) ListChanges(
+	ctx context.Context,
+	k *key.Key,
+	from uint64,
+	limit uint32,
+	actorID string,
+) ([]*api.ChangeSummary, uint64, error) {
+	res, err := c.client.ListChanges(ctx, &api.ListChangesRequest{
+		DocumentKey:   converter.ToDocumentKey(k),
+		FromServerSeq: from,
+		Limit:         limit,
+		ActorId:       actorID,
+	})
+	if err != nil {
+		log.Logger.Error(err)
+		return nil, 0, err
+	}
+
+	return res.Changes, res.NextServerSeq, nil
+}
+
 // IsActive returns whether this client is active or not.
 func (c *Client) IsActive() bool {
 	return c.status == activated
@@ -293,24 +500,75 @@ func (c *Client) sync(ctx context.Context, key *key.Key) error {
 		return errDocumentNotAttached
 	}
 
-	res, err := c.client.PushPull(ctx, &api.PushPullRequest{
-		ClientId:   c.id.String(),
-		ChangePack: converter.ToChangePack(doc.CreateChangePack()),
-	})
-	if err != nil {
-		log.Logger.Error(err)
+	// NOTE: The local changes are saved before pushing them so that they are
+	// kept even if the agent is not reachable.
+	if err := c.save(doc); err != nil {
 		return err
 	}
 
-	pack, err := converter.FromChangePack(res.ChangePack)
+	// NOTE: The agent and this client exchange a limited number of changes in
+	// a pack. We keep syncing with the returned checkpoint until we have
+	// caught up and all the local changes have been pushed.
+	for {
+		pbPack, err := converter.ToChangePack(doc.CreateChangePack())
+		if err != nil {
+			return err
+		}
+
+		res, err := c.client.PushPull(ctx, &api.PushPullRequest{
+			ClientId:   c.id.String(),
+			ChangePack: pbPack,
+		})
+		if err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		pack, err := converter.FromChangePack(res.ChangePack)
+		if err != nil {
+			return err
+		}
+
+		if err := doc.ApplyChangePack(pack); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		if !pack.Partial && !doc.HasLocalChanges() {
+			return c.save(doc)
+		}
+	}
+}
+
+// save stores the snapshot of the given document in the store if this client
+// has one.
+func (c *Client) save(doc *document.Document) error {
+	if c.store == nil {
+		return nil
+	}
+
+	snapshot, err := converter.SnapshotToBytes(doc.Snapshot())
 	if err != nil {
 		return err
 	}
 
-	if err := doc.ApplyChangePack(pack); err != nil {
-		log.Logger.Error(err)
+	if err := c.store.Save(doc.Key(), snapshot); err != nil {
 		return err
 	}
 
+	c.savedAtMapByKey[doc.Key().BSONKey()] = gotime.Now()
 	return nil
 }
+
+// saveLocalChanges saves the given document by its local changes unless it
+// has been saved within SaveInterval. Encoding and syncing the whole snapshot
+// on every keystroke is too costly, so the changes skipped here are saved by
+// a later local change, Sync or Close.
+func (c *Client) saveLocalChanges(doc *document.Document) error {
+	savedAt, ok := c.savedAtMapByKey[doc.Key().BSONKey()]
+	if ok && gotime.Since(savedAt) < SaveInterval {
+		return nil
+	}
+
+	return c.save(doc)
+}
@@ -20,12 +20,15 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/client"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/proxy"
@@ -398,6 +401,105 @@ func TestClientAndDocument(t *testing.T) {
 			syncThenAssertEqual(t, c1, c2, doc1, doc2)
 		})
 
+		t.Run("concurrent counter test", func(t *testing.T) {
+			ctx := context.Background()
+			doc1 := document.New(testCollection, t.Name())
+			err := doc1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetNewCounter("k1", 0)
+				return nil
+			}, "set a counter by c1")
+			assert.Nil(t, err)
+
+			err = c1.Attach(ctx, doc1)
+			assert.Nil(t, err)
+
+			doc2 := document.New(testCollection, t.Name())
+			err = c2.Attach(ctx, doc2)
+			assert.Nil(t, err)
+
+			err = doc1.Update(func(root *proxy.ObjectProxy) error {
+				root.GetCounter("k1").Increase(1).Increase(2)
+				return nil
+			}, "increase k1 by c1")
+			assert.Nil(t, err)
+
+			err = doc2.Update(func(root *proxy.ObjectProxy) error {
+				root.GetCounter("k1").Increase(10)
+				return nil
+			}, "increase k1 by c2")
+			assert.Nil(t, err)
+
+			syncThenAssertEqual(t, c1, c2, doc1, doc2)
+			assert.Equal(t, `{"k1":13}`, doc1.Marshal())
+		})
+
+		t.Run("nested elements in array test", func(t *testing.T) {
+			ctx := context.Background()
+			doc1 := document.New(testCollection, t.Name())
+			err := doc1.Update(func(root *proxy.ObjectProxy) error {
+				cards := root.SetNewArray("k1")
+				cards.AddNewObject().SetNewText("desc").Edit(0, 0, "a")
+				cards.AddNewText().Edit(0, 0, "b")
+				return nil
+			}, "add an object and a text into k1 by c1")
+			assert.Nil(t, err)
+
+			err = c1.Attach(ctx, doc1)
+			assert.Nil(t, err)
+
+			doc2 := document.New(testCollection, t.Name())
+			err = c2.Attach(ctx, doc2)
+			assert.Nil(t, err)
+
+			err = doc1.Update(func(root *proxy.ObjectProxy) error {
+				root.GetArray("k1").GetObject(0).GetText("desc").Edit(1, 1, "1")
+				return nil
+			}, "edit the text in the object by c1")
+			assert.Nil(t, err)
+
+			err = doc2.Update(func(root *proxy.ObjectProxy) error {
+				root.GetArray("k1").GetText(1).Edit(1, 1, "2")
+				return nil
+			}, "edit the text in the array by c2")
+			assert.Nil(t, err)
+
+			syncThenAssertEqual(t, c1, c2, doc1, doc2)
+			assert.Equal(t, `{"k1":[{"desc":"a1"},"b2"]}`, doc1.Marshal())
+		})
+
+		t.Run("concurrent array move test", func(t *testing.T) {
+			ctx := context.Background()
+			doc1 := document.New(testCollection, t.Name())
+			err := doc1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetNewArray("k1").AddInteger(0).AddInteger(1).AddInteger(2)
+				return nil
+			}, "add 0, 1, 2 by c1")
+			assert.Nil(t, err)
+
+			err = c1.Attach(ctx, doc1)
+			assert.Nil(t, err)
+
+			doc2 := document.New(testCollection, t.Name())
+			err = c2.Attach(ctx, doc2)
+			assert.Nil(t, err)
+
+			err = doc1.Update(func(root *proxy.ObjectProxy) error {
+				arr := root.GetArray("k1")
+				arr.MoveBefore(arr.Get(0).CreatedAt(), arr.Get(2).CreatedAt())
+				return nil
+			}, "move 2 before 0 by c1")
+			assert.Nil(t, err)
+
+			err = doc2.Update(func(root *proxy.ObjectProxy) error {
+				arr := root.GetArray("k1")
+				arr.MoveAfter(arr.Get(0).CreatedAt(), arr.Get(2).CreatedAt())
+				return nil
+			}, "move 2 after 0 by c2")
+			assert.Nil(t, err)
+
+			syncThenAssertEqual(t, c1, c2, doc1, doc2)
+		})
+
 		t.Run("text test", func(t *testing.T) {
 			ctx := context.Background()
 
@@ -460,6 +562,102 @@ func TestClientAndDocument(t *testing.T) {
 			syncThenAssertEqual(t, c1, c2, doc1, doc2)
 		})
 
+		t.Run("snapshot test", func(t *testing.T) {
+			ctx := context.Background()
+
+			doc1 := document.New(testCollection, t.Name())
+			err := c1.Attach(ctx, doc1)
+			assert.Nil(t, err)
+
+			// 01. Update changes over snapshot threshold.
+			for i := 0; i < testhelper.TestSnapshotThreshold+1; i++ {
+				err := doc1.Update(func(root *proxy.ObjectProxy) error {
+					root.SetInteger(fmt.Sprintf("%d", i), i)
+					return nil
+				})
+				assert.Nil(t, err)
+			}
+			err = c1.Sync(ctx)
+			assert.Nil(t, err)
+
+			// 02. Makes local changes then pull a snapshot from the agent.
+			doc2 := document.New(testCollection, t.Name())
+			err = doc2.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("key", "value")
+				return nil
+			})
+			assert.Nil(t, err)
+			err = c2.Attach(ctx, doc2)
+			assert.Nil(t, err)
+			assert.Contains(t, doc2.Marshal(), `"key":"value"`)
+
+			syncThenAssertEqual(t, c1, c2, doc1, doc2)
+		})
+
+		t.Run("chunked sync test", func(t *testing.T) {
+			ctx := context.Background()
+
+			doc1 := document.New(testCollection, t.Name())
+			err := c1.Attach(ctx, doc1)
+			assert.Nil(t, err)
+			doc2 := document.New(testCollection, t.Name())
+			err = c2.Attach(ctx, doc2)
+			assert.Nil(t, err)
+
+			// 01. Push changes over the maximum number of operations per pack.
+			for i := 0; i < testhelper.TestMaxOperationsPerPack*2-1; i++ {
+				err := doc1.Update(func(root *proxy.ObjectProxy) error {
+					root.SetInteger(fmt.Sprintf("%d", i), i)
+					return nil
+				})
+				assert.Nil(t, err)
+				err = c1.Sync(ctx)
+				assert.Nil(t, err)
+			}
+
+			// 02. Pull them in chunks with a local change.
+			err = doc2.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("key", "value")
+				return nil
+			})
+			assert.Nil(t, err)
+			err = c2.Sync(ctx)
+			assert.Nil(t, err)
+			assert.False(t, doc2.HasLocalChanges())
+			assert.Contains(t, doc2.Marshal(), `"8":8`)
+
+			syncThenAssertEqual(t, c1, c2, doc1, doc2)
+			assert.Equal(t, doc1.Checkpoint().ServerSeq, doc2.Checkpoint().ServerSeq)
+		})
+
+		t.Run("partial push test", func(t *testing.T) {
+			ctx := context.Background()
+
+			doc1 := document.New(testCollection, t.Name())
+			err := c1.Attach(ctx, doc1)
+			assert.Nil(t, err)
+			doc2 := document.New(testCollection, t.Name())
+			err = c2.Attach(ctx, doc2)
+			assert.Nil(t, err)
+
+			// 01. Make local changes over the maximum number of operations
+			// that the client sends in a pack, then push them in a sync.
+			for i := 0; i < 3; i++ {
+				err := doc1.Update(func(root *proxy.ObjectProxy) error {
+					for j := 0; j < document.MaxOperationsPerPack/2; j++ {
+						root.SetInteger(fmt.Sprintf("%d-%d", i, j), j)
+					}
+					return nil
+				})
+				assert.Nil(t, err)
+			}
+			err = c1.Sync(ctx)
+			assert.Nil(t, err)
+			assert.False(t, doc1.HasLocalChanges())
+
+			syncThenAssertEqual(t, c1, c2, doc1, doc2)
+		})
+
 		t.Run("watch test", func(t *testing.T) {
 			ctx := context.Background()
 
@@ -502,6 +700,158 @@ func TestClientAndDocument(t *testing.T) {
 
 			assert.Equal(t, doc1.Marshal(), doc2.Marshal())
 		})
+
+		t.Run("get document at test", func(t *testing.T) {
+			ctx := context.Background()
+
+			doc := document.New(testCollection, t.Name())
+			err := c1.Attach(ctx, doc)
+			assert.Nil(t, err)
+
+			// 01. Push changes one by one over the snapshot interval.
+			expected := []string{doc.Marshal()}
+			for i := 0; i < testhelper.TestSnapshotInterval+2; i++ {
+				err := doc.Update(func(root *proxy.ObjectProxy) error {
+					root.SetInteger(fmt.Sprintf("%d", i), i)
+					return nil
+				})
+				assert.Nil(t, err)
+				err = c1.Sync(ctx)
+				assert.Nil(t, err)
+				expected = append(expected, doc.Marshal())
+			}
+
+			// 02. Read the document at each server seq.
+			for serverSeq, json := range expected {
+				actual, err := c2.GetDocumentAt(ctx, doc.Key(), uint64(serverSeq))
+				assert.Nil(t, err)
+				assert.Equal(t, json, actual)
+			}
+
+			_, err = c2.GetDocumentAt(ctx, doc.Key(), uint64(len(expected)))
+			assert.NotNil(t, err)
+		})
+
+		t.Run("resume test", func(t *testing.T) {
+			ctx := context.Background()
+			dir, err := ioutil.TempDir("", "yorkie-store")
+			assert.Nil(t, err)
+			defer func() {
+				assert.Nil(t, os.RemoveAll(dir))
+			}()
+			store, err := client.NewFileStore(dir)
+			assert.Nil(t, err)
+
+			// 01. Make local changes and close the client without syncing.
+			c3, err := client.NewClientWithStore(testRPCAddr, t.Name(), store)
+			assert.Nil(t, err)
+			assert.Nil(t, c3.Activate(ctx))
+			doc1 := document.New(testCollection, t.Name())
+			assert.Nil(t, c3.Attach(ctx, doc1))
+			err = doc1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", "v1")
+				return nil
+			})
+			assert.Nil(t, err)
+			assert.Nil(t, c3.Close())
+			snapshot, err := store.Load(doc1.Key())
+			assert.Nil(t, err)
+			pack, err := converter.BytesToSnapshot(snapshot)
+			assert.Nil(t, err)
+			assert.Len(t, pack.Changes, 1)
+
+			// 02. Resume the document with a client of the same key.
+			c4, err := client.NewClientWithStore(testRPCAddr, t.Name(), store)
+			assert.Nil(t, err)
+			assert.Nil(t, c4.Activate(ctx))
+			defer func() {
+				assert.Nil(t, c4.Close())
+			}()
+			doc2 := document.New(testCollection, t.Name())
+			assert.Nil(t, c4.Attach(ctx, doc2))
+			assert.Equal(t, `{"k1":"v1"}`, doc2.Marshal())
+			assert.False(t, doc2.HasLocalChanges())
+
+			// 03. The resumed changes are delivered to the other clients.
+			doc3 := document.New(testCollection, t.Name())
+			assert.Nil(t, c2.Attach(ctx, doc3))
+			assert.Equal(t, doc2.Marshal(), doc3.Marshal())
+
+			assert.Nil(t, c4.Detach(ctx, doc2))
+			_, err = store.Load(doc2.Key())
+			assert.Equal(t, client.ErrSnapshotNotFound, err)
+		})
+
+		t.Run("get document test", func(t *testing.T) {
+			ctx := context.Background()
+
+			doc1 := document.New(testCollection, t.Name())
+			err := c1.Attach(ctx, doc1)
+			assert.Nil(t, err)
+
+			for i := 0; i < testhelper.TestSnapshotInterval+2; i++ {
+				err := doc1.Update(func(root *proxy.ObjectProxy) error {
+					root.SetInteger(fmt.Sprintf("%d", i), i)
+					return nil
+				})
+				assert.Nil(t, err)
+				err = c1.Sync(ctx)
+				assert.Nil(t, err)
+			}
+
+			json, serverSeq, err := c2.GetDocument(ctx, doc1.Key())
+			assert.Nil(t, err)
+			assert.Equal(t, doc1.Marshal(), json)
+			assert.Equal(t, doc1.Checkpoint().ServerSeq, serverSeq)
+
+			_, _, err = c2.GetDocument(ctx, document.New(testCollection, "not-exists").Key())
+			assert.NotNil(t, err)
+		})
+
+		t.Run("list changes test", func(t *testing.T) {
+			ctx := context.Background()
+
+			doc1 := document.New(testCollection, t.Name())
+			err := c1.Attach(ctx, doc1)
+			assert.Nil(t, err)
+			doc2 := document.New(testCollection, t.Name())
+			err = c2.Attach(ctx, doc2)
+			assert.Nil(t, err)
+
+			for i, doc := range []*document.Document{doc1, doc2, doc1} {
+				err := doc.Update(func(root *proxy.ObjectProxy) error {
+					root.SetInteger("k1", i)
+					return nil
+				}, fmt.Sprintf("update %d", i))
+				assert.Nil(t, err)
+				syncThenAssertEqual(t, c1, c2, doc1, doc2)
+			}
+
+			changes, next, err := c1.ListChanges(ctx, doc1.Key(), 0, 2, "")
+			assert.Nil(t, err)
+			assert.Len(t, changes, 2)
+			assert.Equal(t, uint64(3), next)
+			assert.Equal(t, "update 0", changes[0].Message)
+			assert.Equal(t, uint64(1), changes[0].ServerSeq)
+			assert.Len(t, changes[0].Operations, 1)
+			assert.Contains(t, changes[0].Operations[0], `set "k1" = 0`)
+
+			changes, next, err = c1.ListChanges(ctx, doc1.Key(), next, 2, "")
+			assert.Nil(t, err)
+			assert.Len(t, changes, 1)
+			assert.Equal(t, uint64(0), next)
+			assert.Equal(t, "update 2", changes[0].Message)
+
+			changes, _, err = c1.ListChanges(ctx, doc1.Key(), 0, 0, doc1.Actor().String())
+			assert.Nil(t, err)
+			assert.Len(t, changes, 2)
+			for _, c := range changes {
+				assert.Equal(t, doc1.Actor().String(), c.ActorId)
+			}
+
+			_, _, err = c1.ListChanges(ctx, doc1.Key(), 0, 0, "invalid")
+			assert.NotNil(t, err)
+		})
 	})
 }
 
@@ -579,6 +929,9 @@ func withYorkieAndTwoClients(
 
 func withYorkie(t *testing.T, f func(*testing.T, *yorkie.Yorkie)) {
 	conf := yorkie.NewConfigWithPortAndDBName(testhelper.TestPort, testhelper.TestDBName())
+	conf.Backend.SnapshotThreshold = testhelper.TestSnapshotThreshold
+	conf.Backend.SnapshotInterval = testhelper.TestSnapshotInterval
+	conf.Backend.MaxOperationsPerPack = testhelper.TestMaxOperationsPerPack
 	y, err := yorkie.New(conf)
 	if err != nil {
 		t.Fatal(err)
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/log"
+)
+
+// ErrSnapshotNotFound is returned when the store does not have the snapshot
+// of the given document.
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// Store is a local storage of the documents attached to a client. It keeps
+// the snapshots of the documents created by document.Document.Snapshot, which
+// include the checkpoints and the local changes, so that the documents can be
+// resumed after the client restarts.
+type Store interface {
+	// Save stores the snapshot of the document of the given key.
+	Save(k *key.Key, snapshot []byte) error
+
+	// Load returns the snapshot of the document of the given key. It returns
+	// ErrSnapshotNotFound if there is no snapshot of the document.
+	Load(k *key.Key) ([]byte, error)
+
+	// Delete removes the snapshot of the document of the given key.
+	Delete(k *key.Key) error
+}
+
+// FileStore is a Store that keeps the snapshots as files in a directory.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore creates an instance of FileStore with the given directory. The
+// directory is created if it does not exist.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	return &FileStore{dir: dir}, nil
+}
+
+// Save stores the snapshot of the document of the given key. The snapshot is
+// written to a temporary file first and then renamed, so a crash while saving
+// does not break the previous snapshot.
+func (s *FileStore) Save(k *key.Key, snapshot []byte) error {
+	file, err := ioutil.TempFile(s.dir, "snapshot-")
+	if err != nil {
+		log.Logger.Error(err)
+		return err
+	}
+
+	if _, err := file.Write(snapshot); err != nil {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+		log.Logger.Error(err)
+		return err
+	}
+
+	// NOTE: The file is synced before renaming, otherwise a crash may leave
+	// an empty file in place of the previous snapshot.
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+		log.Logger.Error(err)
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		_ = os.Remove(file.Name())
+		log.Logger.Error(err)
+		return err
+	}
+
+	if err := os.Rename(file.Name(), s.path(k)); err != nil {
+		_ = os.Remove(file.Name())
+		log.Logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// Load returns the snapshot of the document of the given key.
+func (s *FileStore) Load(k *key.Key) ([]byte, error) {
+	snapshot, err := ioutil.ReadFile(s.path(k))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrSnapshotNotFound
+		}
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// Delete removes the snapshot of the document of the given key.
+func (s *FileStore) Delete(k *key.Key) error {
+	if err := os.Remove(s.path(k)); err != nil && !os.IsNotExist(err) {
+		log.Logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// path returns the path of the snapshot file of the given key. The key is
+// escaped because the collection and the document may contain separators.
+func (s *FileStore) path(k *key.Key) string {
+	return filepath.Join(s.dir, url.PathEscape(k.BSONKey())+".snapshot")
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "yorkie-store")
+	assert.Nil(t, err)
+	defer func() {
+		assert.Nil(t, os.RemoveAll(dir))
+	}()
+
+	store, err := client.NewFileStore(dir)
+	assert.Nil(t, err)
+
+	k1 := &key.Key{Collection: "c1", Document: "d/1"}
+	k2 := &key.Key{Collection: "c1", Document: "d2"}
+
+	_, err = store.Load(k1)
+	assert.Equal(t, client.ErrSnapshotNotFound, err)
+
+	assert.Nil(t, store.Save(k1, []byte("snapshot1")))
+	assert.Nil(t, store.Save(k2, []byte("snapshot2")))
+	assert.Nil(t, store.Save(k1, []byte("snapshot3")))
+
+	snapshot, err := store.Load(k1)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("snapshot3"), snapshot)
+
+	assert.Nil(t, store.Delete(k1))
+	assert.Nil(t, store.Delete(k1))
+	_, err = store.Load(k1)
+	assert.Equal(t, client.ErrSnapshotNotFound, err)
+
+	snapshot, err = store.Load(k2)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("snapshot2"), snapshot)
+}
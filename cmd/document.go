@@ -0,0 +1,128 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/log"
+	"github.com/yorkie-team/yorkie/yorkie"
+)
+
+var (
+	flagRPCAddr   string
+	flagServerSeq uint64
+)
+
+func newDocumentCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "document [command]",
+		Short: "Reads documents stored in yorkie agent.",
+	}
+}
+
+func newDocumentGetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get [collection] [document]",
+		Short: "Gets the current content of the document.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := client.NewClient(flagRPCAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cli.Close(); err != nil {
+					log.Logger.Error(err)
+				}
+			}()
+
+			doc, _, err := cli.GetDocument(
+				context.Background(),
+				&key.Key{Collection: args[0], Document: args[1]},
+			)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), doc)
+			return nil
+		},
+	}
+}
+
+func newDocumentShowCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show [collection] [document] --at [server seq]",
+		Short: "Shows the document as it was at the given server seq.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := client.NewClient(flagRPCAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cli.Close(); err != nil {
+					log.Logger.Error(err)
+				}
+			}()
+
+			doc, err := cli.GetDocumentAt(
+				context.Background(),
+				&key.Key{Collection: args[0], Document: args[1]},
+				flagServerSeq,
+			)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), doc)
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newDocumentCmd()
+	cmd.PersistentFlags().StringVar(
+		&flagRPCAddr,
+		"rpc-addr",
+		fmt.Sprintf("localhost:%d", yorkie.DefaultRPCPort),
+		"address of the rpc server",
+	)
+
+	cmd.AddCommand(newDocumentGetCmd())
+
+	showCmd := newDocumentShowCmd()
+	showCmd.Flags().Uint64Var(
+		&flagServerSeq,
+		"at",
+		0,
+		"server seq of the document to show",
+	)
+	if err := showCmd.MarkFlagRequired("at"); err != nil {
+		panic(err)
+	}
+	cmd.AddCommand(showCmd)
+
+	rootCmd.AddCommand(cmd)
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/log"
+	"github.com/yorkie-team/yorkie/yorkie"
+)
+
+var (
+	flagFromServerSeq uint64
+	flagLimit         uint32
+	flagActorID       string
+)
+
+func newLogCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "log [collection] [document]",
+		Short: "Shows the changes of the document.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := client.NewClient(flagRPCAddr)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cli.Close(); err != nil {
+					log.Logger.Error(err)
+				}
+			}()
+
+			changes, next, err := cli.ListChanges(
+				context.Background(),
+				&key.Key{Collection: args[0], Document: args[1]},
+				flagFromServerSeq,
+				flagLimit,
+				flagActorID,
+			)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			for _, c := range changes {
+				fmt.Fprintf(out, "change %d\n", c.ServerSeq)
+				fmt.Fprintf(out, "Actor:     %s\n", c.ActorId)
+				fmt.Fprintf(out, "ClientSeq: %d\n", c.ClientSeq)
+				fmt.Fprintf(out, "Lamport:   %d\n", c.Lamport)
+				if c.Message != "" {
+					fmt.Fprintf(out, "\n    %s\n", c.Message)
+				}
+				fmt.Fprintln(out)
+				for _, op := range c.Operations {
+					fmt.Fprintf(out, "    %s\n", op)
+				}
+				fmt.Fprintln(out)
+			}
+
+			if next != 0 {
+				fmt.Fprintf(out, "(more changes from server seq %d, use --from %d)\n", next, next)
+			}
+
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newLogCmd()
+	cmd.Flags().StringVar(
+		&flagRPCAddr,
+		"rpc-addr",
+		fmt.Sprintf("localhost:%d", yorkie.DefaultRPCPort),
+		"address of the rpc server",
+	)
+	cmd.Flags().Uint64Var(
+		&flagFromServerSeq,
+		"from",
+		1,
+		"server seq of the first change to show",
+	)
+	cmd.Flags().Uint32VarP(
+		&flagLimit,
+		"limit",
+		"n",
+		20,
+		"maximum number of changes to show",
+	)
+	cmd.Flags().StringVar(
+		&flagActorID,
+		"actor",
+		"",
+		"show only the changes made by the given actor",
+	)
+	rootCmd.AddCommand(cmd)
+}
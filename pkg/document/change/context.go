@@ -68,7 +68,29 @@ func (c *Context) Push(op operation.Operation) {
 	c.operations = append(c.operations, op)
 }
 
-// RegisterElement registers the given element to the root.
-func (c *Context) RegisterElement(elem json.Element) {
-	c.root.RegisterElement(elem)
+// FindByCreatedAt returns the element of the given creation time.
+func (c *Context) FindByCreatedAt(createdAt *time.Ticket) json.Element {
+	return c.root.FindByCreatedAt(createdAt)
+}
+
+// RegisterElement registers the given element of the given parent to the
+// root.
+func (c *Context) RegisterElement(parent json.Container, elem json.Element) {
+	c.root.RegisterElement(parent, elem)
+}
+
+// RegisterRemovedElementPair registers the given element pair to hash table.
+func (c *Context) RegisterRemovedElementPair(parent json.Container, deleted json.Element) {
+	c.root.RegisterRemovedElementPair(parent, deleted)
+}
+
+// RegisterTextWithGarbage registers the given text that has removed nodes.
+func (c *Context) RegisterTextWithGarbage(text *json.Text) {
+	c.root.RegisterTextWithGarbage(text)
+}
+
+// RegisterArrayWithGarbage registers the given array that has positions
+// elements moved away from.
+func (c *Context) RegisterArrayWithGarbage(array *json.Array) {
+	c.root.RegisterArrayWithGarbage(array)
 }
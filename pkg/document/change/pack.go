@@ -19,6 +19,7 @@ package change
 import (
 	"github.com/yorkie-team/yorkie/pkg/document/checkpoint"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
 // Pack is a unit for delivering changes in a document to the remote.
@@ -26,6 +27,19 @@ type Pack struct {
 	DocumentKey *key.Key
 	Checkpoint  *checkpoint.Checkpoint
 	Changes     []*Change
+
+	// MinSyncedTicket is the minimum logical time taken by clients who attach
+	// the document. It used to collect garbage on the replica on the client.
+	MinSyncedTicket *time.Ticket
+
+	// Snapshot is the snapshot of the document taken by the agent. If it is
+	// not nil, the changes of this pack are the changes after the snapshot.
+	Snapshot *Snapshot
+
+	// Partial is true if the agent could not exchange all the changes within
+	// a pack. The client should sync again with the returned checkpoint to
+	// catch up.
+	Partial bool
 }
 
 // NewPack creates a new instance of Pack.
@@ -45,3 +59,8 @@ func NewPack(
 func (p *Pack) HasChanges() bool {
 	return len(p.Changes) > 0
 }
+
+// HasSnapshot returns the whether pack has a snapshot or not.
+func (p *Pack) HasSnapshot() bool {
+	return p.Snapshot != nil
+}
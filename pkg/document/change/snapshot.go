@@ -0,0 +1,39 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package change
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+)
+
+// Snapshot is the state of a document at a change. The root contains the
+// CRDT metadata such as tombstones and time tickets.
+type Snapshot struct {
+	// ID is the ID of the last change applied to the root.
+	ID *ID
+
+	// Root is the root object of the document.
+	Root *json.Object
+}
+
+// NewSnapshot creates a new instance of Snapshot.
+func NewSnapshot(id *ID, root *json.Object) *Snapshot {
+	return &Snapshot{
+		ID:   id,
+		Root: root,
+	}
+}
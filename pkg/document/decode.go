@@ -0,0 +1,166 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	time2 "time"
+
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+)
+
+var (
+	// ErrInvalidDecodeTarget is returned when the target of Decode is not a
+	// non-nil pointer.
+	ErrInvalidDecodeTarget = errors.New("decode target should be a non-nil pointer")
+
+	timeType = reflect.TypeOf(time2.Time{})
+)
+
+// Decode stores the content of the given document in the value pointed to by
+// the given v. Struct fields are bound to members by the yorkie tag in the
+// same way as proxy.ObjectProxy.Encode. Members that have no matching field
+// are ignored.
+func Decode(doc *Document, v interface{}) error {
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Ptr || target.IsNil() {
+		return ErrInvalidDecodeTarget
+	}
+
+	return decodeElement(doc.root.Object(), target.Elem(), "$")
+}
+
+func decodeElement(elem json.Element, target reflect.Value, path string) error {
+	if primitive, ok := elem.(*json.Primitive); ok && primitive.ValueType() == json.Null {
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+
+	switch target.Kind() {
+	case reflect.Ptr:
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		return decodeElement(elem, target.Elem(), path)
+	case reflect.Interface:
+		if target.NumMethod() != 0 {
+			break
+		}
+		target.Set(reflect.ValueOf(json.ValueOf(elem)))
+		return nil
+	case reflect.Struct:
+		if target.Type() == timeType {
+			break
+		}
+
+		obj, ok := elem.(*json.Object)
+		if !ok {
+			break
+		}
+
+		for _, field := range json.StructFields(target.Type()) {
+			member := obj.Get(field.Key)
+			if member == nil {
+				continue
+			}
+
+			memberPath := json.MemberPath(path, field.Key)
+			if err := decodeElement(member, target.Field(field.Index), memberPath); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		obj, ok := elem.(*json.Object)
+		if !ok || target.Type().Key().Kind() != reflect.String {
+			break
+		}
+
+		if target.IsNil() {
+			target.Set(reflect.MakeMap(target.Type()))
+		}
+		for key, member := range obj.Members() {
+			value := reflect.New(target.Type().Elem()).Elem()
+			if err := decodeElement(member, value, json.MemberPath(path, key)); err != nil {
+				return err
+			}
+			target.SetMapIndex(reflect.ValueOf(key).Convert(target.Type().Key()), value)
+		}
+		return nil
+	case reflect.Slice:
+		arr, ok := elem.(*json.Array)
+		if !ok {
+			break
+		}
+
+		elements := arr.Elements()
+		slice := reflect.MakeSlice(target.Type(), len(elements), len(elements))
+		for i, element := range elements {
+			if err := decodeElement(element, slice.Index(i), json.ElementPath(path, i)); err != nil {
+				return err
+			}
+		}
+		target.Set(slice)
+		return nil
+	case reflect.String:
+		if text, ok := elem.(*json.Text); ok {
+			target.SetString(text.String())
+			return nil
+		}
+	}
+
+	return decodeValue(elem, target, path)
+}
+
+// decodeValue stores the value of the given primitive or counter in the
+// given target converting its type if needed.
+func decodeValue(elem json.Element, target reflect.Value, path string) error {
+	var value interface{}
+	switch elem := elem.(type) {
+	case *json.Primitive:
+		value = elem.Value()
+	case *json.Counter:
+		value = elem.Value()
+	default:
+		return fmt.Errorf("%w: %s", json.ErrUnexpectedType, path)
+	}
+
+	source := reflect.ValueOf(value)
+	switch {
+	case source.Type().AssignableTo(target.Type()):
+		target.Set(source)
+	case isNumber(source.Kind()) && isNumber(target.Kind()):
+		target.Set(source.Convert(target.Type()))
+	default:
+		return fmt.Errorf("%w: %s", json.ErrUnexpectedType, path)
+	}
+
+	return nil
+}
+
+func isNumber(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
@@ -18,11 +18,14 @@ package document
 
 import (
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/checkpoint"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/operation"
 	"github.com/yorkie-team/yorkie/pkg/document/proxy"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/log"
@@ -40,6 +43,16 @@ const (
 	Attached stateType = 1
 )
 
+// maxUndoStackLen is the maximum number of changes that can be undone. The
+// oldest change is dropped when it is exceeded, so that the elements removed
+// by it can be collected.
+const maxUndoStackLen = 100
+
+// MaxOperationsPerPack is the maximum number of operations of the local
+// changes sent to the server in a pack. It keeps a request small even if a lot
+// of local changes have been made while the document was not synchronized.
+const MaxOperationsPerPack = 1000
+
 // Document represents a document in MongoDB and contains logical clocks.
 //
 // How document works:
@@ -55,6 +68,25 @@ type Document struct {
 	checkpoint   *checkpoint.Checkpoint
 	changeID     *change.ID
 	localChanges []*change.Change
+
+	// packedClientSeq is the client sequence of the last local change sent
+	// in a pack. The changes up to it may have been saved on the server, so
+	// they are not compacted again.
+	packedClientSeq uint32
+
+	// undoStack and redoStack hold the operations of the local changes to be
+	// reverted by Undo and Redo.
+	undoStack [][]operation.Operation
+	redoStack [][]operation.Operation
+	reverter  *proxy.Reverter
+
+	// handlers are the subscribers of the change events of this document.
+	handlers  map[int]EventHandler
+	handlerID int
+
+	// localChangeHandler is called after a local change is made, e.g. to save
+	// the document.
+	localChangeHandler func() error
 }
 
 // New creates a new instance of Document.
@@ -67,9 +99,53 @@ func New(collection, document string) *Document {
 		root:       json.NewRoot(root),
 		checkpoint: checkpoint.Initial,
 		changeID:   change.InitialID,
+		reverter:   proxy.NewReverter(),
+		handlers:   make(map[int]EventHandler),
+	}
+}
+
+// FromSnapshot creates a new instance of Document from the given pack which
+// is created by Snapshot. The pack should have the snapshot.
+func FromSnapshot(pack *change.Pack) *Document {
+	changeID := pack.Snapshot.ID
+	return &Document{
+		key:          pack.DocumentKey,
+		state:        Detached,
+		root:         json.NewRoot(pack.Snapshot.Root),
+		checkpoint:   pack.Checkpoint,
+		changeID:     changeID,
+		localChanges: pack.Changes,
+		reverter:     proxy.NewReverter(),
+		handlers:     make(map[int]EventHandler),
+
+		// NOTE: The local changes of the snapshot may have been sent already.
+		packedClientSeq: changeID.ClientSeq(),
 	}
 }
 
+// Restore replaces the state of this document with the given pack which is
+// created by Snapshot, including the checkpoint and the local changes. It is
+// used to resume a document saved before.
+func (d *Document) Restore(pack *change.Pack) {
+	doc := FromSnapshot(pack)
+	oldValue := d.root.Object().Marshal()
+	d.root = doc.root
+	d.clone = nil
+	d.checkpoint = doc.checkpoint
+	d.changeID = doc.changeID
+	d.localChanges = doc.localChanges
+	d.packedClientSeq = doc.packedClientSeq
+	d.undoStack = nil
+	d.redoStack = nil
+
+	d.publish(&ChangeEvent{
+		Type:     SnapshotEvent,
+		Path:     "$",
+		OldValue: oldValue,
+		NewValue: d.root.Object().Marshal(),
+	})
+}
+
 // Key returns the key of this document.
 func (d *Document) Key() *key.Key {
 	return d.key
@@ -85,31 +161,186 @@ func (d *Document) Update(
 	updater func(root *proxy.ObjectProxy) error,
 	msgAndArgs ...interface{},
 ) error {
+	ops, err := d.update(func(ctx *change.Context) error {
+		return updater(proxy.NewObjectProxy(ctx, d.clone.Object()))
+	}, messageFromMsgAndArgs(msgAndArgs))
+	if err != nil {
+		return err
+	}
+
+	if isUndoable(ops) {
+		d.undoStack = pushRevertible(d.undoStack, ops)
+		d.redoStack = nil
+	}
+
+	return d.handleLocalChange(ops)
+}
+
+// isUndoable returns whether the given operations change the content or not.
+// Changes that only move the selections are not recorded for undo.
+func isUndoable(ops []operation.Operation) bool {
+	for _, op := range ops {
+		if _, ok := op.(*operation.Select); !ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Undo reverts the last local change which has not been undone. The reverting
+// operations are computed against the current state, so remote changes made
+// in the meantime are not overwritten.
+func (d *Document) Undo() error {
+	if !d.CanUndo() {
+		return nil
+	}
+
+	ops := d.undoStack[len(d.undoStack)-1]
+	d.undoStack = d.undoStack[:len(d.undoStack)-1]
+
+	reverted, err := d.update(func(ctx *change.Context) error {
+		d.reverter.Revert(ctx, ops)
+		return nil
+	}, "undo")
+	if err != nil {
+		return err
+	}
+
+	if len(reverted) > 0 {
+		d.redoStack = pushRevertible(d.redoStack, reverted)
+	}
+
+	return d.handleLocalChange(reverted)
+}
+
+// Redo reverts the last change made by Undo.
+func (d *Document) Redo() error {
+	if !d.CanRedo() {
+		return nil
+	}
+
+	ops := d.redoStack[len(d.redoStack)-1]
+	d.redoStack = d.redoStack[:len(d.redoStack)-1]
+
+	reverted, err := d.update(func(ctx *change.Context) error {
+		d.reverter.Revert(ctx, ops)
+		return nil
+	}, "redo")
+	if err != nil {
+		return err
+	}
+
+	if len(reverted) > 0 {
+		d.undoStack = pushRevertible(d.undoStack, reverted)
+	}
+
+	return d.handleLocalChange(reverted)
+}
+
+// SetLocalChangeHandler sets the handler called after a local change is made
+// by Update, Undo or Redo. The error of the handler is returned by them, but
+// the change is kept.
+func (d *Document) SetLocalChangeHandler(handler func() error) {
+	d.localChangeHandler = handler
+}
+
+// handleLocalChange calls the local change handler if the given operations
+// have been made.
+func (d *Document) handleLocalChange(ops []operation.Operation) error {
+	if d.localChangeHandler == nil || len(ops) == 0 {
+		return nil
+	}
+
+	return d.localChangeHandler()
+}
+
+// pushRevertible pushes the given operations into the given stack. If the
+// stack exceeds maxUndoStackLen, the oldest operations are dropped.
+func pushRevertible(
+	stack [][]operation.Operation,
+	ops []operation.Operation,
+) [][]operation.Operation {
+	stack = append(stack, ops)
+	if len(stack) > maxUndoStackLen {
+		stack = append(stack[:0], stack[1:]...)
+	}
+
+	return stack
+}
+
+// dropRevertibleBefore drops the operations in the given stack that were
+// executed at or before the given time, because the elements removed by them
+// may have been purged.
+func dropRevertibleBefore(
+	stack [][]operation.Operation,
+	ticket *time.Ticket,
+) [][]operation.Operation {
+	var kept [][]operation.Operation
+	for _, ops := range stack {
+		if ops[0].ExecutedAt().Lamport() > ticket.Lamport() {
+			kept = append(kept, ops)
+		}
+	}
+
+	return kept
+}
+
+// CanUndo returns whether this document has local changes to undo or not.
+func (d *Document) CanUndo() bool {
+	return len(d.undoStack) > 0
+}
+
+// CanRedo returns whether this document has undone changes to redo or not.
+func (d *Document) CanRedo() bool {
+	return len(d.redoStack) > 0
+}
+
+// update executes the given mutator on the clone, then applies the change
+// made by it to the root. It returns the operations of the change.
+func (d *Document) update(
+	mutator func(ctx *change.Context) error,
+	message string,
+) ([]operation.Operation, error) {
 	d.ensureClone()
 	ctx := change.NewContext(
 		d.changeID.Next(),
-		messageFromMsgAndArgs(msgAndArgs),
+		message,
 		d.clone,
 	)
 
-	if err := updater(proxy.NewObjectProxy(ctx, d.clone.Object())); err != nil {
+	if err := mutator(ctx); err != nil {
 		// drop copy because it is contaminated.
 		d.clone = nil
 		log.Logger.Error(err)
-		return err
+		return nil, err
 	}
 
-	if ctx.HasOperations() {
-		c := ctx.ToChange()
-		if err := c.Execute(d.root); err != nil {
-			return err
-		}
+	if !ctx.HasOperations() {
+		return nil, nil
+	}
 
-		d.localChanges = append(d.localChanges, c)
-		d.changeID = ctx.ID()
+	c := ctx.ToChange()
+	if err := d.executeChange(c, true); err != nil {
+		return nil, err
 	}
 
-	return nil
+	d.localChanges = append(d.localChanges, c)
+	d.changeID = ctx.ID()
+
+	return c.Operations(), nil
+}
+
+// Get returns the value of the given path, e.g. $.todos[2].title. Objects and
+// arrays are returned as map[string]interface{} and []interface{}, and texts
+// are returned as strings.
+func (d *Document) Get(path string) (interface{}, error) {
+	elem, err := d.root.FindByPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.ValueOf(elem), nil
 }
 
 // HasLocalChanges returns whether this document has local changes or not.
@@ -119,7 +350,14 @@ func (d *Document) HasLocalChanges() bool {
 
 // ApplyChangePack applies the given change pack into this document.
 func (d *Document) ApplyChangePack(pack *change.Pack) error {
-	// 01. Apply remote changes to both the clone and the document.
+	// 01. Apply the snapshot and remote changes to both the clone and the
+	// document.
+	if pack.HasSnapshot() {
+		if err := d.applySnapshot(pack.Snapshot); err != nil {
+			return err
+		}
+	}
+
 	d.ensureClone()
 	for _, c := range pack.Changes {
 		if err := c.Execute(d.clone); err != nil {
@@ -129,7 +367,7 @@ func (d *Document) ApplyChangePack(pack *change.Pack) error {
 
 	for _, c := range pack.Changes {
 		d.changeID = d.changeID.SyncLamport(c.ID())
-		if err := c.Execute(d.root); err != nil {
+		if err := d.executeChange(c, false); err != nil {
 			return err
 		}
 	}
@@ -143,9 +381,18 @@ func (d *Document) ApplyChangePack(pack *change.Pack) error {
 		d.localChanges = d.localChanges[1:]
 	}
 
-	// 03. Update the checkpoint.
+	// 03. Update the checkpoint. If the pack is partial, the checkpoint only
+	// covers the changes in it and the rest are applied by the next packs.
 	d.checkpoint = d.checkpoint.Forward(pack.Checkpoint)
 
+	// 04. Do Garbage collection. The elements removed by the changes that
+	// can be undone or redone are kept.
+	if pack.MinSyncedTicket != nil {
+		if ticket := d.revertibleGCTicket(pack.MinSyncedTicket); ticket != nil {
+			d.GarbageCollect(ticket)
+		}
+	}
+
 	log.Logger.Debugf("after apply %d changes: %s", len(pack.Changes), d.root.Object().Marshal())
 	return nil
 }
@@ -155,14 +402,158 @@ func (d *Document) Marshal() string {
 	return d.root.Object().Marshal()
 }
 
+// MarshalJSON returns the JSON encoding of this document. It implements
+// encoding/json.Marshaler.
+func (d *Document) MarshalJSON() ([]byte, error) {
+	return d.root.Object().MarshalJSON()
+}
+
+// MarshalTo writes the JSON encoding of this document to the given writer
+// without building the whole encoding in memory.
+func (d *Document) MarshalTo(w io.Writer) error {
+	if err := json.MarshalTo(d.root.Object(), w); err != nil {
+		log.Logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// GarbageCollect purge elements that were removed before the given time.
+// The changes made before the given time can not be undone or redone after
+// it, because the elements to restore them may be purged.
+func (d *Document) GarbageCollect(ticket *time.Ticket) int {
+	d.undoStack = dropRevertibleBefore(d.undoStack, ticket)
+	d.redoStack = dropRevertibleBefore(d.redoStack, ticket)
+
+	if d.clone != nil {
+		d.clone.GarbageCollect(ticket)
+	}
+	return d.root.GarbageCollect(ticket)
+}
+
+// revertibleGCTicket returns the given ticket or, if it is later, the ticket
+// right before the oldest change that can be undone or redone. It returns nil
+// if nothing can be collected.
+func (d *Document) revertibleGCTicket(ticket *time.Ticket) *time.Ticket {
+	lamport := ticket.Lamport()
+	for _, stack := range [][][]operation.Operation{d.undoStack, d.redoStack} {
+		for _, ops := range stack {
+			if executedAt := ops[0].ExecutedAt(); executedAt.Lamport() <= lamport {
+				if executedAt.Lamport() == 0 {
+					return nil
+				}
+				lamport = executedAt.Lamport() - 1
+			}
+		}
+	}
+
+	if lamport == ticket.Lamport() {
+		return ticket
+	}
+
+	return time.NewTicket(lamport, time.MaxDelimiter, time.MaxActorID)
+}
+
+// GarbageLen returns the count of removed elements.
+func (d *Document) GarbageLen() int {
+	return d.root.GarbageLen()
+}
+
+// Snapshot returns the pack of this document with the snapshot including the
+// CRDT metadata such as tombstones and time tickets, the checkpoint and the
+// local changes. The root of the snapshot is shared with this document, so it
+// should be encoded before the document is updated.
+func (d *Document) Snapshot() *change.Pack {
+	pack := change.NewPack(d.key, d.checkpoint, d.localChanges)
+	pack.Snapshot = change.NewSnapshot(d.changeID, d.root.Object())
+	return pack
+}
+
 // CreateChangePack creates pack of the local changes to send to the server.
+// The local changes which have not been sent yet are batched into a change
+// before creating the pack, but their operations are sent as they are. The
+// pack holds at most MaxOperationsPerPack operations unless its first change
+// alone has more, and the rest are left to the next packs.
 func (d *Document) CreateChangePack() *change.Pack {
-	changes := d.localChanges
+	d.compactLocalChanges()
 
-	cp := d.checkpoint.IncreaseClientSeq(uint32(len(changes)))
+	var changes []*change.Change
+	ops := 0
+	for _, c := range d.localChanges {
+		ops += len(c.Operations())
+		if len(changes) > 0 && ops > MaxOperationsPerPack {
+			break
+		}
+		changes = append(changes, c)
+	}
+
+	cp := d.checkpoint
+	if len(changes) > 0 {
+		lastClientSeq := changes[len(changes)-1].ClientSeq()
+		if lastClientSeq > d.packedClientSeq {
+			d.packedClientSeq = lastClientSeq
+		}
+		cp = cp.SyncClientSeq(lastClientSeq)
+	}
 	return change.NewPack(d.key, cp, changes)
 }
 
+// compactLocalChanges merges the local changes which have not been sent yet
+// into changes having the IDs of the last ones. A merged change holds at most
+// MaxOperationsPerPack operations so that it fits in a pack, unless a change
+// alone has more. The operations keep their time tickets, so the merged
+// changes have the same effect as the original changes on the other replicas.
+//
+// NOTE: The operations themselves are not merged, e.g. typed characters are
+// not merged into an edit. The characters inserted and deleted by an edit are
+// identified and stamped with the time ticket of the edit, so a merged edit
+// would make them differ between this replica and the others.
+func (d *Document) compactLocalChanges() {
+	start := len(d.localChanges)
+	for start > 0 && d.localChanges[start-1].ClientSeq() > d.packedClientSeq {
+		start--
+	}
+	pending := d.localChanges[start:]
+	if len(pending) == 0 {
+		return
+	}
+
+	compacted := d.localChanges[:start:start]
+	var batch []*change.Change
+	ops := 0
+	for _, c := range pending {
+		if len(batch) > 0 && ops+len(c.Operations()) > MaxOperationsPerPack {
+			compacted = append(compacted, mergeChanges(batch))
+			batch = nil
+			ops = 0
+		}
+		batch = append(batch, c)
+		ops += len(c.Operations())
+	}
+	d.localChanges = append(compacted, mergeChanges(batch))
+}
+
+// mergeChanges merges the given changes into a change having the ID of the
+// last one.
+func mergeChanges(changes []*change.Change) *change.Change {
+	if len(changes) == 1 {
+		return changes[0]
+	}
+
+	var ops []operation.Operation
+	var messages []string
+	for _, c := range changes {
+		ops = append(ops, c.Operations()...)
+		if c.Message() != "" {
+			messages = append(messages, c.Message())
+		}
+	}
+
+	last := changes[len(changes)-1]
+	return change.New(last.ID(), strings.Join(messages, "; "), ops)
+}
+
 // SetActor sets actor into this document. This is also applied in the local
 // changes the document has.
 func (d *Document) SetActor(actor *time.ActorID) {
@@ -187,6 +578,32 @@ func (d *Document) IsAttached() bool {
 	return d.state == Attached
 }
 
+// applySnapshot replaces the root of this document with the root of the given
+// snapshot. The local changes are executed again on the new root because the
+// snapshot may not contain them.
+func (d *Document) applySnapshot(snapshot *change.Snapshot) error {
+	root := json.NewRoot(snapshot.Root)
+	for _, c := range d.localChanges {
+		if err := c.Execute(root); err != nil {
+			return err
+		}
+	}
+
+	oldValue := d.root.Object().Marshal()
+	d.root = root
+	d.clone = nil
+	d.changeID = d.changeID.SyncLamport(snapshot.ID)
+
+	d.publish(&ChangeEvent{
+		Type:     SnapshotEvent,
+		Path:     "$",
+		OldValue: oldValue,
+		NewValue: d.root.Object().Marshal(),
+	})
+
+	return nil
+}
+
 func (d *Document) ensureClone() {
 	if d.clone == nil {
 		d.clone = d.root.Deepcopy()
@@ -17,14 +17,25 @@
 package document_test
 
 import (
+	"bytes"
+	gojson "encoding/json"
 	"errors"
+	"fmt"
+	"math"
 	"testing"
+	time2 "time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/checkpoint"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operation"
 	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
 var (
@@ -175,6 +186,1646 @@ func TestDocument(t *testing.T) {
 		assert.Equal(t, `{"k1":"하늘"}`, doc.Marshal())
 	})
 
+	t.Run("text style test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			text := root.SetNewText("k1").Edit(0, 0, "Hello world")
+			text.Style(0, 5, map[string]string{"bold": "true"})
+			assert.Equal(t,
+				`[{"attrs":{"bold":"true"},"content":"Hello"},{"content":" world"}]`,
+				text.MarshalWithAttrs(),
+			)
+
+			text.Style(3, 8, map[string]string{"italic": "true"})
+			assert.Equal(t,
+				`[{"attrs":{"bold":"true"},"content":"Hel"},`+
+					`{"attrs":{"bold":"true","italic":"true"},"content":"lo"},`+
+					`{"attrs":{"italic":"true"},"content":" wo"},{"content":"rld"}]`,
+				text.MarshalWithAttrs(),
+			)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"k1":"Hello world"}`, doc.Marshal())
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			text := root.GetText("k1")
+			text.Style(0, 11, map[string]string{"bold": "false", "italic": "false"})
+			text.Edit(5, 6, "")
+			assert.Equal(t,
+				`[{"attrs":{"bold":"false","italic":"false"},"content":"Helloworld"}]`,
+				text.MarshalWithAttrs(),
+			)
+			return nil
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("concurrent text style test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("k1").Edit(0, 0, "abcd")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetText("k1").Style(0, 3, map[string]string{"bold": "true", "link": "a"})
+			return nil
+		})
+		assert.Nil(t, err)
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.GetText("k1").
+				Edit(2, 2, "X").
+				Style(1, 5, map[string]string{"link": "b"})
+			return nil
+		})
+		assert.Nil(t, err)
+
+		syncDocument(t, doc1, doc2)
+		syncDocument(t, doc2, doc1)
+
+		var text1, text2 string
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			text1 = root.GetText("k1").MarshalWithAttrs()
+			return nil
+		})
+		assert.Nil(t, err)
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			text2 = root.GetText("k1").MarshalWithAttrs()
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, text1, text2)
+		assert.Equal(t,
+			`[{"attrs":{"bold":"true","link":"a"},"content":"a"},`+
+				`{"attrs":{"bold":"true","link":"b"},"content":"b"},`+
+				`{"attrs":{"link":"b"},"content":"X"},`+
+				`{"attrs":{"bold":"true","link":"b"},"content":"c"},`+
+				`{"attrs":{"link":"b"},"content":"d"}]`,
+			text1,
+		)
+	})
+
+	t.Run("counter test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewCounter("age", 5).Increase(1).Increase(2)
+			root.SetNewCounter("price", 121.5).Increase(3.5)
+			root.SetNewArray("counts").AddNewCounter(int64(3)).Increase(int64(4))
+			assert.Equal(t, `{"age":8,"counts":[7],"price":125.000000}`, root.Marshal())
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"age":8,"counts":[7],"price":125.000000}`, doc.Marshal())
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.GetCounter("age").Increase(-10)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"age":-2,"counts":[7],"price":125.000000}`, doc.Marshal())
+	})
+
+	t.Run("garbage collection test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("1").AddInteger(1).AddInteger(2).AddInteger(3)
+			root.SetNewArray("2").AddInteger(1).AddInteger(2).AddInteger(3)
+			root.SetString("3", "v3")
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"1":[1,2,3],"2":[1,2,3],"3":"v3"}`, doc.Marshal())
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("1").Remove(1)
+			root.Remove("2")
+			root.SetString("3", "v4")
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"1":[1,3],"3":"v4"}`, doc.Marshal())
+		assert.Equal(t, 6, doc.GarbageLen())
+
+		assert.Equal(t, 6, doc.GarbageCollect(time.MaxTicket))
+		assert.Equal(t, 0, doc.GarbageLen())
+		assert.Equal(t, `{"1":[1,3],"3":"v4"}`, doc.Marshal())
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("1").AddInteger(4)
+			root.SetString("3", "v5")
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"1":[1,3,4],"3":"v5"}`, doc.Marshal())
+	})
+
+	t.Run("text selection test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("k1").Edit(0, 0, "Hello world")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.GetText("k1").Select(6, 11)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.False(t, doc2.CanUndo())
+		syncDocument(t, doc2, doc1)
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			text := root.GetText("k1")
+			text.Edit(0, 5, "Hi")
+			text.Select(2, 2)
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+
+		expected := map[string]*json.TextRange{
+			"000000000000000000000001": {From: 2, To: 2},
+			"000000000000000000000002": {From: 3, To: 8},
+		}
+		for _, doc := range []*document.Document{doc1, doc2} {
+			assert.Equal(t, `{"k1":"Hi world"}`, doc.Marshal())
+			err = doc.Update(func(root *proxy.ObjectProxy) error {
+				assert.Equal(t, expected, root.GetText("k1").Selections())
+				return nil
+			})
+			assert.Nil(t, err)
+			assert.False(t, doc.HasLocalChanges())
+		}
+	})
+
+	t.Run("text anchor test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		var anchors []string
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			text := root.SetNewText("k1").Edit(0, 0, "Hello world")
+			for _, pair := range []struct {
+				index   int
+				gravity json.Gravity
+			}{{5, json.LeftGravity}, {6, json.RightGravity}, {11, json.RightGravity}} {
+				anchor, err := text.CreateAnchor(pair.index, pair.gravity)
+				assert.Nil(t, err)
+				anchors = append(anchors, anchor.String())
+			}
+
+			_, err := text.CreateAnchor(12, json.LeftGravity)
+			assert.True(t, errors.Is(err, json.ErrIndexOutOfRange))
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetText("k1").Edit(0, 2, "")
+			return nil
+		})
+		assert.Nil(t, err)
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.GetText("k1").Edit(5, 5, "!")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+		syncDocument(t, doc2, doc1)
+		assert.Equal(t, `{"k1":"llo! world"}`, doc1.Marshal())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		for _, doc := range []*document.Document{doc1, doc2} {
+			err = doc.Update(func(root *proxy.ObjectProxy) error {
+				var indexes []int
+				for _, str := range anchors {
+					anchor, err := json.ParseTextAnchor(str)
+					assert.Nil(t, err)
+					index, err := root.GetText("k1").ResolveAnchor(anchor)
+					assert.Nil(t, err)
+					indexes = append(indexes, index)
+				}
+				assert.Equal(t, []int{3, 5, 10}, indexes)
+				return nil
+			})
+			assert.Nil(t, err)
+		}
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			text := root.GetText("k1").Edit(4, 6, "")
+			anchor, err := json.ParseTextAnchor(anchors[1])
+			assert.Nil(t, err)
+			index, err := text.ResolveAnchor(anchor)
+			assert.Nil(t, err)
+			assert.Equal(t, 4, index)
+			return nil
+		})
+		assert.Nil(t, err)
+
+		_, err = json.ParseTextAnchor("1:0:invalid:0:L")
+		assert.True(t, errors.Is(err, json.ErrInvalidAnchor))
+	})
+
+	t.Run("text anchor after garbage collection test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+
+		var anchors []*json.TextAnchor
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			text := root.SetNewText("k1").Edit(0, 0, "Hello world")
+			for _, pair := range []struct {
+				index   int
+				gravity json.Gravity
+			}{{2, json.LeftGravity}, {5, json.LeftGravity}, {6, json.RightGravity}, {8, json.RightGravity}, {10, json.LeftGravity}} {
+				anchor, err := text.CreateAnchor(pair.index, pair.gravity)
+				assert.Nil(t, err)
+				anchors = append(anchors, anchor)
+			}
+			return nil
+		})
+		assert.Nil(t, err)
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.GetText("k1").Edit(4, 9, "").Edit(0, 2, "")
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"k1":"llld"}`, doc.Marshal())
+		assert.Equal(t, 2, doc.GarbageCollect(time.MaxTicket))
+
+		for _, doc := range []*document.Document{doc, document.FromSnapshot(encodeSnapshot(t, doc))} {
+			err = doc.Update(func(root *proxy.ObjectProxy) error {
+				var indexes []int
+				for _, anchor := range anchors {
+					index, err := root.GetText("k1").ResolveAnchor(anchor)
+					assert.Nil(t, err)
+					indexes = append(indexes, index)
+				}
+				assert.Equal(t, []int{0, 2, 2, 2, 3}, indexes)
+				return nil
+			})
+			assert.Nil(t, err)
+		}
+	})
+
+	t.Run("text garbage collection test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("k1").
+				Edit(0, 0, "ABCD").
+				Edit(1, 3, "12")
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"k1":"A12D"}`, doc.Marshal())
+		assert.Equal(t, 1, doc.GarbageLen())
+
+		assert.Equal(t, 1, doc.GarbageCollect(time.MaxTicket))
+		assert.Equal(t, 0, doc.GarbageLen())
+		assert.Equal(t, `{"k1":"A12D"}`, doc.Marshal())
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			text := root.GetText("k1")
+			assert.Equal(t,
+				"[0:0:00:0 ][1:2:00:0 A][1:3:00:0 12][1:2:00:3 D]",
+				text.AnnotatedString(),
+			)
+			text.Edit(3, 3, "3").Edit(0, 1, "")
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"k1":"123D"}`, doc.Marshal())
+	})
+
+	t.Run("concurrent text edit with garbage collection test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("k1").Edit(0, 0, "ABCD")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+
+		// 01. doc2 edits inside the text that doc1 deletes concurrently.
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetText("k1").Edit(1, 3, "")
+			return nil
+		})
+		assert.Nil(t, err)
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.GetText("k1").Edit(2, 2, "X")
+			return nil
+		})
+		assert.Nil(t, err)
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.GetText("k1").Edit(3, 4, "Y")
+			return nil
+		})
+		assert.Nil(t, err)
+
+		// 02. doc1 purges the deleted text before receiving the edits.
+		assert.Equal(t, 1, doc1.GarbageCollect(time.MaxTicket))
+		syncDocument(t, doc2, doc1)
+		syncDocument(t, doc1, doc2)
+		assert.Equal(t, `{"k1":"AXYD"}`, doc1.Marshal())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("concurrent text edit after garbage collection test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("k1").Edit(0, 0, "ABCD")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetText("k1").Edit(1, 3, "")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+		assert.Equal(t, `{"k1":"AD"}`, doc1.Marshal())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		assert.Equal(t, 1, doc1.GarbageCollect(time.MaxTicket))
+		assert.Equal(t, 1, doc2.GarbageCollect(time.MaxTicket))
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetText("k1").Edit(1, 1, "X")
+			return nil
+		})
+		assert.Nil(t, err)
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.GetText("k1").Edit(1, 2, "Y")
+			return nil
+		})
+		assert.Nil(t, err)
+
+		syncDocument(t, doc1, doc2)
+		syncDocument(t, doc2, doc1)
+		assert.Equal(t, `{"k1":"AYX"}`, doc1.Marshal())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("nested elements in array test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			cards := root.SetNewArray("cards")
+			card := cards.AddNewObject()
+			card.SetString("title", "todo")
+			card.SetNewText("desc").Edit(0, 0, "hello")
+			cards.AddNewText().Edit(0, 0, "memo")
+			cards.AddNewArray().AddInteger(1)
+			cards.AddNewCounter(1)
+			assert.Equal(t,
+				`{"cards":[{"desc":"hello","title":"todo"},"memo",[1],1]}`,
+				root.Marshal(),
+			)
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			cards := root.GetArray("cards")
+			cards.GetObject(0).GetText("desc").Edit(5, 5, " world")
+			cards.GetText(1).Edit(0, 0, "my ")
+			cards.GetArray(2).AddInteger(2)
+			cards.GetCounter(3).Increase(2)
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc2, doc1)
+		assert.Equal(t,
+			`{"cards":[{"desc":"hello world","title":"todo"},"my memo",[1,2],3]}`,
+			doc1.Marshal(),
+		)
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("array insert test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.SetNewArray("k1").AddInteger(1).AddInteger(3)
+			arr.InsertAt(1, 2).InsertAt(0, 0).InsertAt(4, 4)
+			assert.Equal(t, `{"k1":[0,1,2,3,4]}`, root.Marshal())
+
+			arr.InsertValueAfter(arr.Get(0).CreatedAt(), "a")
+			arr.InsertValueBefore(arr.Get(0).CreatedAt(), true)
+			assert.Equal(t, `{"k1":[true,0,"a",1,2,3,4]}`, root.Marshal())
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"k1":[true,0,"a",1,2,3,4]}`, doc.Marshal())
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			removed := arr.Splice(1, 2, "b", "c", "d")
+			assert.Len(t, removed, 2)
+			assert.Equal(t, `{"k1":[true,"b","c","d",1,2,3,4]}`, root.Marshal())
+
+			arr.Splice(0, 4)
+			assert.Equal(t, `{"k1":[1,2,3,4]}`, root.Marshal())
+
+			arr.InsertAt(1, int64(5))
+			assert.Equal(t, `{"k1":[1,5,2,3,4]}`, root.Marshal())
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"k1":[1,5,2,3,4]}`, doc.Marshal())
+	})
+
+	t.Run("array insert at unknown position test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddInteger(0)
+			return nil
+		})
+		assert.Nil(t, err)
+		pack := doc.CreateChangePack()
+		assert.Nil(t, doc.ApplyChangePack(change.NewPack(pack.DocumentKey, pack.Checkpoint, nil)))
+
+		// inserts around unknown elements are ignored without making
+		// operations.
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			arr.InsertValueBefore(time.MaxTicket, 1)
+			arr.InsertValueAfter(time.MaxTicket, map[string]interface{}{"k": "v"})
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.False(t, doc.HasLocalChanges())
+		assert.Equal(t, `{"k1":[0]}`, doc.Marshal())
+	})
+
+	t.Run("array insert of every value type test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.SetNewArray("k1").AddInteger(1)
+			arr.InsertNewObjectAt(0).SetString("title", "todo")
+			arr.InsertNewArrayAt(1).AddInteger(2)
+			arr.InsertNewTextAt(2).Edit(0, 0, "memo")
+			arr.InsertNewCounterAt(3, 10).Increase(5)
+			arr.InsertAt(4, map[string]interface{}{"done": true})
+			arr.Splice(5, 1, []interface{}{"a", 3})
+			assert.Nil(t, arr.InsertNewObjectAt(10))
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(
+			t,
+			`{"k1":[{"title":"todo"},[2],"memo",15,{"done":true},["a",3]]}`,
+			doc1.Marshal(),
+		)
+
+		syncDocument(t, doc1, doc2)
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		changes := len(doc1.CreateChangePack().Changes)
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("k1").InsertAt(0, make(chan int)).Splice(0, 1, "b", func() {})
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Len(t, doc1.CreateChangePack().Changes, changes)
+	})
+
+	t.Run("concurrent array insert test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddInteger(1).AddInteger(2)
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("k1").InsertAt(1, "a").InsertAt(2, "b")
+			return nil
+		})
+		assert.Nil(t, err)
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("k1").InsertAt(1, "c")
+			return nil
+		})
+		assert.Nil(t, err)
+
+		syncDocument(t, doc1, doc2)
+		syncDocument(t, doc2, doc1)
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+		assert.Equal(t, `{"k1":[1,"c","a","b",2]}`, doc1.Marshal())
+	})
+
+	t.Run("array move test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddInteger(0).AddInteger(1).AddInteger(2)
+			assert.Equal(t, `{"k1":[0,1,2]}`, root.Marshal())
+			return nil
+		})
+		assert.Nil(t, err)
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			arr.MoveBefore(arr.Get(0).CreatedAt(), arr.Get(2).CreatedAt())
+			assert.Equal(t, `{"k1":[2,0,1]}`, root.Marshal())
+
+			arr.MoveAfter(arr.Get(2).CreatedAt(), arr.Get(0).CreatedAt())
+			assert.Equal(t, `{"k1":[0,1,2]}`, root.Marshal())
+
+			arr.MoveBefore(arr.Get(2).CreatedAt(), arr.Get(0).CreatedAt())
+			assert.Equal(t, `{"k1":[1,0,2]}`, root.Marshal())
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"k1":[1,0,2]}`, doc.Marshal())
+	})
+
+	t.Run("array move of unknown element test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddInteger(0).AddInteger(1)
+			return nil
+		})
+		assert.Nil(t, err)
+		pack := doc.CreateChangePack()
+		assert.Nil(t, doc.ApplyChangePack(change.NewPack(pack.DocumentKey, pack.Checkpoint, nil)))
+
+		// moves of unknown elements or before unknown elements are ignored
+		// without making operations.
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			arr.MoveBefore(time.MaxTicket, arr.Get(0).CreatedAt())
+			arr.MoveAfter(arr.Get(1).CreatedAt(), time.MaxTicket)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.False(t, doc.HasLocalChanges())
+		assert.Equal(t, `{"k1":[0,1]}`, doc.Marshal())
+	})
+
+	t.Run("concurrent array move test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddInteger(0).AddInteger(1).AddInteger(2)
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			arr.MoveBefore(arr.Get(0).CreatedAt(), arr.Get(2).CreatedAt())
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"k1":[2,0,1]}`, doc1.Marshal())
+
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			arr.MoveAfter(arr.Get(0).CreatedAt(), arr.Get(2).CreatedAt())
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"k1":[0,2,1]}`, doc2.Marshal())
+
+		syncDocument(t, doc1, doc2)
+		syncDocument(t, doc2, doc1)
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+		assert.Equal(t, `{"k1":[0,2,1]}`, doc1.Marshal())
+	})
+
+	t.Run("concurrent array move of different elements test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddString("A").AddString("B").AddString("C")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			arr.MoveAfter(arr.Get(1).CreatedAt(), arr.Get(0).CreatedAt())
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"k1":["B","A","C"]}`, doc1.Marshal())
+
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			arr.MoveAfter(arr.Get(2).CreatedAt(), arr.Get(1).CreatedAt())
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"k1":["A","C","B"]}`, doc2.Marshal())
+
+		// NOTE: A is placed after the position where B was when it was moved,
+		// not after where B is now.
+		syncDocument(t, doc1, doc2)
+		syncDocument(t, doc2, doc1)
+		assert.Equal(t, `{"k1":["A","C","B"]}`, doc1.Marshal())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		// inserting after a moved element and moving it again concurrently.
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("k1").InsertAt(1, "D")
+			return nil
+		})
+		assert.Nil(t, err)
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			arr.MoveBefore(arr.Get(0).CreatedAt(), arr.Get(2).CreatedAt())
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+		syncDocument(t, doc2, doc1)
+		assert.Equal(t, `{"k1":["B","A","D","C"]}`, doc1.Marshal())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		// the positions that elements moved away from are purged.
+		assert.Equal(t, 3, doc1.GarbageLen())
+		assert.Equal(t, 3, doc1.GarbageCollect(time.MaxTicket))
+		assert.Equal(t, 0, doc1.GarbageLen())
+		assert.Equal(t, doc2.Marshal(), doc1.Marshal())
+	})
+
+	t.Run("array move after purged position test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddString("A").AddString("B").AddString("C")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("k1").Remove(1)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, doc1.GarbageCollect(time.MaxTicket))
+
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			arr := root.GetArray("k1")
+			arr.MoveAfter(arr.Get(1).CreatedAt(), arr.Get(0).CreatedAt())
+			return nil
+		})
+		assert.Nil(t, err)
+
+		pack := doc2.CreateChangePack()
+		err = doc1.ApplyChangePack(change.NewPack(pack.DocumentKey, checkpoint.Initial, pack.Changes))
+		assert.True(t, errors.Is(err, json.ErrPositionNotFound))
+	})
+
+	t.Run("snapshot test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetNewArray("k2").AddInteger(1).AddInteger(2).AddInteger(3)
+			root.SetNewText("k3").Edit(0, 0, "ABCD").Edit(1, 3, "12")
+			root.SetNewCounter("k4", 1)
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v2")
+			arr := root.GetArray("k2")
+			arr.Remove(0)
+			arr.MoveBefore(arr.Get(0).CreatedAt(), arr.Get(1).CreatedAt())
+			root.GetText("k3").Style(0, 2, map[string]string{"bold": "true"})
+			root.GetCounter("k4").Increase(2)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"k1":"v2","k2":[3,2],"k3":"A12D","k4":3}`, doc1.Marshal())
+
+		restored := document.FromSnapshot(encodeSnapshot(t, doc1))
+		assert.Equal(t, doc1.Key(), restored.Key())
+		assert.Equal(t, doc1.Checkpoint(), restored.Checkpoint())
+		assert.Equal(t, doc1.Actor(), restored.Actor())
+		assert.Equal(t, doc1.Marshal(), restored.Marshal())
+		assert.Equal(t, doc1.GarbageLen(), restored.GarbageLen())
+		assert.True(t, restored.HasLocalChanges())
+
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("k2").InsertAt(1, 4)
+			root.GetText("k3").Edit(2, 3, "3")
+			return nil
+		})
+		assert.Nil(t, err)
+
+		syncDocument(t, restored, doc2)
+		syncDocument(t, doc2, restored)
+		assert.Equal(t, `{"k1":"v2","k2":[3,4,2],"k3":"A13D","k4":3}`, restored.Marshal())
+		assert.Equal(t, restored.Marshal(), doc2.Marshal())
+
+		err = restored.Update(func(root *proxy.ObjectProxy) error {
+			assert.Equal(t,
+				`[{"attrs":{"bold":"true"},"content":"A1"},{"content":"3D"}]`,
+				root.GetText("k3").MarshalWithAttrs(),
+			)
+			return nil
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("restore test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		})
+		assert.Nil(t, err)
+
+		snapshot := encodeSnapshot(t, doc1)
+
+		doc2 := document.New("c1", "d1")
+		var events []*document.ChangeEvent
+		doc2.Subscribe(func(event *document.ChangeEvent) {
+			events = append(events, event)
+		})
+		doc2.Restore(snapshot)
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+		assert.Equal(t, doc1.Checkpoint(), doc2.Checkpoint())
+		assert.Equal(t, doc1.Actor(), doc2.Actor())
+		assert.True(t, doc2.HasLocalChanges())
+		assert.Len(t, events, 1)
+		assert.Equal(t, document.SnapshotEvent, events[0].Type)
+
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		})
+		assert.Nil(t, err)
+		pack := doc2.CreateChangePack()
+		assert.Equal(t, uint32(2), pack.Checkpoint.ClientSeq)
+		assert.Len(t, pack.Changes, 2)
+
+		_, err = converter.BytesToSnapshot([]byte("invalid"))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("apply change pack with snapshot test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewArray("k1").AddInteger(1).AddInteger(2)
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, document.New("c1", "d1"))
+		snapshot := encodeSnapshot(t, doc1)
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("k1").AddInteger(3)
+			return nil
+		})
+		assert.Nil(t, err)
+
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		})
+		assert.Nil(t, err)
+
+		pack := change.NewPack(
+			doc2.Key(),
+			checkpoint.New(3, 0),
+			doc1.CreateChangePack().Changes,
+		)
+		pack.Snapshot = snapshot.Snapshot
+		pbPack, err := converter.ToChangePack(pack)
+		assert.Nil(t, err)
+		pack, err = converter.FromChangePack(pbPack)
+		assert.Nil(t, err)
+		assert.Nil(t, doc2.ApplyChangePack(pack))
+		assert.Equal(t, `{"k1":[1,2,3],"k2":"v2"}`, doc2.Marshal())
+		assert.True(t, doc2.HasLocalChanges())
+
+		syncDocument(t, doc2, doc1)
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("undo/redo test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		assert.False(t, doc.CanUndo())
+
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetNewArray("k2").AddInteger(1).AddInteger(2)
+			root.SetNewText("k3").Edit(0, 0, "ABCD")
+			return nil
+		})
+		assert.Nil(t, err)
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v2")
+			root.GetArray("k2").Remove(0)
+			root.GetArray("k2").AddInteger(3)
+			root.GetText("k3").Edit(1, 3, "12")
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"k1":"v2","k2":[2,3],"k3":"A12D"}`, doc.Marshal())
+
+		assert.Nil(t, doc.Undo())
+		assert.Equal(t, `{"k1":"v1","k2":[1,2],"k3":"ABCD"}`, doc.Marshal())
+		assert.True(t, doc.CanRedo())
+
+		assert.Nil(t, doc.Undo())
+		assert.Equal(t, `{}`, doc.Marshal())
+		assert.False(t, doc.CanUndo())
+
+		assert.Nil(t, doc.Redo())
+		assert.Equal(t, `{"k1":"v1","k2":[1,2],"k3":"ABCD"}`, doc.Marshal())
+		assert.Nil(t, doc.Redo())
+		assert.Equal(t, `{"k1":"v2","k2":[2,3],"k3":"A12D"}`, doc.Marshal())
+		assert.False(t, doc.CanRedo())
+
+		assert.Nil(t, doc.Undo())
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k4", "v4")
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.False(t, doc.CanRedo())
+	})
+
+	t.Run("undo with concurrent changes test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetNewText("k2").Edit(0, 0, "ABC")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v2")
+			root.GetText("k2").Edit(3, 3, "D")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v3")
+			root.GetText("k2").Edit(0, 0, "X")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc2, doc1)
+		assert.Equal(t, `{"k1":"v3","k2":"XABCD"}`, doc1.Marshal())
+
+		// k1 was overwritten by doc2, so only the text edit is reverted.
+		assert.Nil(t, doc1.Undo())
+		assert.Equal(t, `{"k1":"v3","k2":"XABC"}`, doc1.Marshal())
+
+		syncDocument(t, doc1, doc2)
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("undo after garbage collection test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetNewText("k2").Edit(0, 0, "ABCD")
+			return nil
+		})
+		assert.Nil(t, err)
+
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.Remove("k1")
+			root.GetText("k2").Edit(1, 3, "")
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"k2":"AD"}`, doc.Marshal())
+		assert.Equal(t, 2, doc.GarbageLen())
+
+		// The elements removed by the changes to undo are kept.
+		pack := change.NewPack(doc.Key(), checkpoint.Initial, nil)
+		pack.MinSyncedTicket = time.MaxTicket
+		assert.Nil(t, doc.ApplyChangePack(pack))
+		assert.Equal(t, 2, doc.GarbageLen())
+
+		assert.Nil(t, doc.Undo())
+		assert.Equal(t, `{"k1":"v1","k2":"ABCD"}`, doc.Marshal())
+
+		// The changes made before the purged elements can not be reverted.
+		assert.Equal(t, 2, doc.GarbageCollect(time.MaxTicket))
+		assert.Equal(t, 0, doc.GarbageLen())
+		assert.False(t, doc.CanUndo())
+		assert.False(t, doc.CanRedo())
+	})
+
+	t.Run("local change handler test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		count := 0
+		doc.SetLocalChangeHandler(func() error {
+			count++
+			return nil
+		})
+
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		})
+		assert.Nil(t, err)
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, count)
+
+		assert.Nil(t, doc.Undo())
+		assert.Nil(t, doc.Redo())
+		assert.Equal(t, 3, count)
+
+		// the error of the handler is returned, but the change is kept.
+		errSave := errors.New("save failed")
+		doc.SetLocalChangeHandler(func() error {
+			return errSave
+		})
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		})
+		assert.Equal(t, errSave, err)
+		assert.Equal(t, `{"k1":"v1","k2":"v2"}`, doc.Marshal())
+	})
+
+	t.Run("undo stack limit test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		for i := 0; i < 150; i++ {
+			err := doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k1", i)
+				return nil
+			})
+			assert.Nil(t, err)
+		}
+
+		for doc.CanUndo() {
+			assert.Nil(t, doc.Undo())
+		}
+		assert.Equal(t, `{"k1":49}`, doc.Marshal())
+	})
+
+	t.Run("conflicts test", func(t *testing.T) {
+		actor1 := time.ActorIDFromHex("000000000000000000000001")
+		actor2 := time.ActorIDFromHex("000000000000000000000002")
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(actor1)
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(actor2)
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		})
+		assert.Nil(t, err)
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v2")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+		syncDocument(t, doc2, doc1)
+		assert.Equal(t, `{"k1":"v2"}`, doc1.Marshal())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		doc3 := document.FromSnapshot(encodeSnapshot(t, doc1))
+
+		for _, doc := range []*document.Document{doc1, doc2, doc3} {
+			err = doc.Update(func(root *proxy.ObjectProxy) error {
+				conflicts := root.Conflicts("k1")
+				assert.Len(t, conflicts, 2)
+				assert.Equal(t, `"v2"`, conflicts[0].Marshal())
+				assert.Equal(t, actor2, conflicts[0].CreatedAt().ActorID())
+				assert.Equal(t, `"v1"`, conflicts[1].Marshal())
+				assert.Equal(t, actor1, conflicts[1].CreatedAt().ActorID())
+				return nil
+			})
+			assert.Nil(t, err)
+		}
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+		assert.Equal(t, `{"k1":"v1"}`, doc2.Marshal())
+
+		for _, doc := range []*document.Document{doc1, doc2} {
+			err = doc.Update(func(root *proxy.ObjectProxy) error {
+				conflicts := root.Conflicts("k1")
+				assert.Len(t, conflicts, 1)
+				assert.Equal(t, actor1, conflicts[0].CreatedAt().ActorID())
+				return nil
+			})
+			assert.Nil(t, err)
+		}
+		assert.Equal(t, 2, doc2.GarbageLen())
+	})
+
+	t.Run("conflicts remove and garbage collection test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		// 01. doc2 removes its own value without seeing the value of doc1.
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1").SetString("k2", "v1")
+			return nil
+		})
+		assert.Nil(t, err)
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v2").SetString("k2", "v2")
+			root.Remove("k1")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+		syncDocument(t, doc2, doc1)
+		assert.Equal(t, `{"k1":"v1","k2":"v2"}`, doc1.Marshal())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		// 02. garbage collection does not bring back removed values.
+		for _, doc := range []*document.Document{doc1, doc2} {
+			doc.GarbageCollect(time.MaxTicket)
+			assert.Equal(t, `{"k1":"v1","k2":"v2"}`, doc.Marshal())
+			err = doc.Update(func(root *proxy.ObjectProxy) error {
+				assert.Len(t, root.Conflicts("k1"), 1)
+				assert.Len(t, root.Conflicts("k2"), 2)
+				return nil
+			})
+			assert.Nil(t, err)
+		}
+
+		// 03. removing a key removes its concurrent values together.
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.Remove("k2")
+			assert.False(t, root.Has("k2"))
+			assert.Len(t, root.Conflicts("k2"), 0)
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+		assert.Equal(t, `{"k1":"v1"}`, doc2.Marshal())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		for _, doc := range []*document.Document{doc1, doc2} {
+			assert.Equal(t, 2, doc.GarbageCollect(time.MaxTicket))
+			assert.Equal(t, `{"k1":"v1"}`, doc.Marshal())
+		}
+	})
+
+	t.Run("change events test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		var events []*document.ChangeEvent
+		unsubscribe := doc2.Subscribe(func(event *document.ChangeEvent) {
+			events = append(events, event)
+		})
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewObject("k1").SetNewArray("k1.1").AddString("a").AddString("b")
+			root.SetNewText("k2").Edit(0, 0, "ABC")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+		assert.Len(t, events, 6)
+		assert.Equal(t, document.AddEvent, events[3].Type)
+		assert.Equal(t, `$.k1["k1.1"][1]`, events[3].Path)
+		assert.Equal(t, `"b"`, events[3].NewValue)
+		assert.False(t, events[3].IsLocal)
+		assert.Equal(t, doc1.Actor(), events[3].Actor)
+
+		events = nil
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.GetObject("k1").GetArray("k1.1").Remove(0)
+			root.GetText("k2").Edit(1, 2, "12")
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []*document.ChangeEvent{{
+			Type:     document.RemoveEvent,
+			Path:     `$.k1["k1.1"][0]`,
+			OldValue: `"a"`,
+			IsLocal:  true,
+			Actor:    doc2.Actor(),
+		}, {
+			Type: document.EditEvent,
+			Path: "$.k2",
+			TextChanges: []*json.TextChange{
+				{Actor: doc2.Actor(), From: 1, To: 2, Content: "12"},
+			},
+			IsLocal: true,
+			Actor:   doc2.Actor(),
+		}}, events)
+
+		events = nil
+		unsubscribe()
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k3", "v3")
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Len(t, events, 0)
+	})
+
+	t.Run("compact local changes test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		edit := func(doc *document.Document, from, to int, content string) {
+			err := doc.Update(func(root *proxy.ObjectProxy) error {
+				root.GetText("k1").Edit(from, to, content)
+				return nil
+			})
+			assert.Nil(t, err)
+		}
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("k1").Edit(0, 0, "12")
+			return nil
+		}, "create")
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+
+		// 01. typing, backspaces and replacing are packed into a change. The
+		// operations are kept as they are.
+		for i, c := range "abc" {
+			edit(doc1, 1+i, 1+i, string(c))
+		}
+		edit(doc1, 3, 4, "")
+		edit(doc1, 2, 3, "")
+		edit(doc1, 2, 2, "x")
+		edit(doc2, 0, 0, "z")
+		edit(doc2, 2, 2, "y")
+
+		pack := doc1.CreateChangePack()
+		assert.Len(t, pack.Changes, 1)
+		assert.Len(t, pack.Changes[0].Operations(), 6)
+		assert.Equal(t, uint32(7), pack.Checkpoint.ClientSeq)
+		assert.Nil(t, doc2.ApplyChangePack(change.NewPack(pack.DocumentKey, checkpoint.Initial, pack.Changes)))
+		assert.Nil(t, doc1.ApplyChangePack(change.NewPack(pack.DocumentKey, pack.Checkpoint, nil)))
+		syncDocument(t, doc2, doc1)
+		assert.Equal(t, `{"k1":"z1yax2"}`, doc1.Marshal())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		// 02. changes are packed across the remote changes.
+		edit(doc1, 0, 6, "abcd")
+		syncDocument(t, doc1, doc2)
+		edit(doc1, 2, 4, "")
+		edit(doc2, 3, 3, "p")
+		edit(doc2, 1, 1, "q")
+		syncDocument(t, doc2, doc1)
+		edit(doc1, 0, 3, "")
+
+		pack = doc1.CreateChangePack()
+		assert.Len(t, pack.Changes, 1)
+		assert.Len(t, pack.Changes[0].Operations(), 2)
+		syncDocument(t, doc1, doc2)
+		assert.Equal(t, `{"k1":"p"}`, doc1.Marshal())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("change pack operations limit test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc2 := document.New("c1", "d1")
+
+		// 01. three changes of the half of the limit are packed into two
+		// changes, and a pack only has the first one.
+		for i := 0; i < 3; i++ {
+			err := doc1.Update(func(root *proxy.ObjectProxy) error {
+				for j := 0; j < document.MaxOperationsPerPack/2; j++ {
+					root.SetInteger(fmt.Sprintf("%d-%d", i, j), j)
+				}
+				return nil
+			})
+			assert.Nil(t, err)
+		}
+
+		pack := doc1.CreateChangePack()
+		assert.Len(t, pack.Changes, 1)
+		assert.Len(t, pack.Changes[0].Operations(), document.MaxOperationsPerPack)
+		assert.Equal(t, uint32(2), pack.Checkpoint.ClientSeq)
+		assert.Nil(t, doc2.ApplyChangePack(change.NewPack(pack.DocumentKey, checkpoint.Initial, pack.Changes)))
+		assert.Nil(t, doc1.ApplyChangePack(change.NewPack(pack.DocumentKey, pack.Checkpoint, nil)))
+		assert.True(t, doc1.HasLocalChanges())
+
+		// 02. the rest is sent in the next pack.
+		pack = doc1.CreateChangePack()
+		assert.Len(t, pack.Changes, 1)
+		assert.Len(t, pack.Changes[0].Operations(), document.MaxOperationsPerPack/2)
+		assert.Equal(t, uint32(3), pack.Checkpoint.ClientSeq)
+		assert.Nil(t, doc2.ApplyChangePack(change.NewPack(pack.DocumentKey, checkpoint.Initial, pack.Changes)))
+		assert.Nil(t, doc1.ApplyChangePack(change.NewPack(pack.DocumentKey, pack.Checkpoint, nil)))
+		assert.False(t, doc1.HasLocalChanges())
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+	})
+
+	t.Run("text change events test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewText("k1").Edit(0, 0, "Hello world")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+
+		var changes []*json.TextChange
+		unsubscribe := doc2.Subscribe(func(event *document.ChangeEvent) {
+			assert.Equal(t, document.EditEvent, event.Type)
+			changes = append(changes, event.TextChanges...)
+		})
+		defer unsubscribe()
+
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			root.GetText("k1").Edit(2, 11, "")
+			return nil
+		})
+		assert.Nil(t, err)
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.GetText("k1").Edit(6, 6, "big ")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc1, doc2)
+		assert.Equal(t, `{"k1":"Hebig "}`, doc2.Marshal())
+
+		actor1 := time.ActorIDFromHex("000000000000000000000001")
+		actor2 := time.ActorIDFromHex("000000000000000000000002")
+		assert.Equal(t, []*json.TextChange{
+			{Actor: actor2, From: 6, To: 6, Content: "big "},
+			{Actor: actor1, From: 2, To: 6},
+			{Actor: actor1, From: 6, To: 11},
+		}, changes)
+
+		changes = nil
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.GetText("k1").Edit(0, 2, "Hi, ")
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []*json.TextChange{
+			{Actor: actor2, From: 0, To: 2, Content: "Hi, "},
+		}, changes)
+	})
+
+	t.Run("path test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			todos := root.SetNewArray("todos")
+			todos.AddNewObject().SetString("title", "a")
+			todos.AddNewObject().SetString("title", "b")
+
+			assert.Nil(t, root.SetPath("$.settings.theme", "dark"))
+			assert.Nil(t, root.SetPath("$.todos[1].done", true))
+			assert.Nil(t, root.SetPath("$.todos[2]", "c"))
+			assert.Nil(t, root.SetPath("$.todos[0]", "d"))
+
+			err := root.SetPath("$.todos[5]", "e")
+			assert.True(t, errors.Is(err, json.ErrPathNotFound))
+			err = root.SetPath("$.settings.theme.color", "red")
+			assert.True(t, errors.Is(err, json.ErrUnexpectedType))
+			err = root.SetPath("$.settings", []string{"dark"})
+			assert.True(t, errors.Is(err, proxy.ErrUnsupportedValue))
+			err = root.SetPath("$.todos[5].title", "e")
+			assert.True(t, errors.Is(err, json.ErrPathNotFound))
+			err = root.SetPath("$.todos[-1]", "e")
+			assert.NotNil(t, err)
+			err = root.SetPath("$.settings.theme.color.name", "red")
+			assert.True(t, errors.Is(err, json.ErrUnexpectedType))
+			err = root.SetPath("$.todos.title", "e")
+			assert.True(t, errors.Is(err, json.ErrUnexpectedType))
+
+			obj, err := root.GetObjectByPath("$.todos[1]")
+			assert.Nil(t, err)
+			obj.SetString("title", "B")
+			_, err = root.GetArrayByPath("$.todos[1]")
+			assert.True(t, errors.Is(err, json.ErrUnexpectedType))
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(
+			t,
+			`{"settings":{"theme":"dark"},"todos":["d",{"done":true,"title":"B"},"c"]}`,
+			doc.Marshal(),
+		)
+
+		title, err := doc.Get("$.todos[1].title")
+		assert.Nil(t, err)
+		assert.Equal(t, "B", title)
+
+		todo, err := doc.Get("$.todos[1]")
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"done": true, "title": "B"}, todo)
+
+		_, err = doc.Get("$.todos[3].title")
+		assert.True(t, errors.Is(err, json.ErrPathNotFound))
+		_, err = doc.Get("$.todos.title")
+		assert.True(t, errors.Is(err, json.ErrUnexpectedType))
+	})
+
+	t.Run("set value test", func(t *testing.T) {
+		doc1 := document.New("c1", "d1")
+		doc1.SetActor(time.ActorIDFromHex("000000000000000000000001"))
+		doc2 := document.New("c1", "d1")
+		doc2.SetActor(time.ActorIDFromHex("000000000000000000000002"))
+
+		err := doc1.Update(func(root *proxy.ObjectProxy) error {
+			if err := root.SetFromJSON("k1", []byte(`{"a":[1,2.5,"x",null],"b":{"c":true}}`)); err != nil {
+				return err
+			}
+			return root.SetValue("k2", map[string]interface{}{
+				"d": []string{"y", "z"},
+				"e": int64(1) << 40,
+			})
+		})
+		assert.Nil(t, err)
+		assert.Equal(
+			t,
+			`{"k1":{"a":[1,2.500000,"x",null],"b":{"c":true}},"k2":{"d":["y","z"],"e":1099511627776}}`,
+			doc1.Marshal(),
+		)
+
+		pack := doc1.CreateChangePack()
+		assert.Len(t, pack.Changes[0].Operations(), 2)
+
+		// NOTE: the subtree of each operation should be kept after encoding.
+		pbPack, err := converter.ToChangePack(pack)
+		assert.Nil(t, err)
+		decoded, err := converter.FromChangePack(pbPack)
+		assert.Nil(t, err)
+		assert.Nil(t, doc2.ApplyChangePack(change.NewPack(
+			decoded.DocumentKey,
+			checkpoint.Initial,
+			decoded.Changes,
+		)))
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		// A broken subtree is reported as an error.
+		pbPack, err = converter.ToChangePack(pack)
+		assert.Nil(t, err)
+		pbPack.Changes[0].Operations[0].GetSet().Value.Subtree = &api.SnapshotElement{}
+		_, err = converter.FromChangePack(pbPack)
+		assert.NotNil(t, err)
+
+		err = doc2.Update(func(root *proxy.ObjectProxy) error {
+			root.GetObject("k1").GetArray("a").AddInteger(3)
+			root.GetObject("k2").Remove("e")
+			return nil
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc2, doc1)
+		assert.Equal(t, doc1.Marshal(), doc2.Marshal())
+
+		changes := len(doc1.CreateChangePack().Changes)
+		err = doc1.Update(func(root *proxy.ObjectProxy) error {
+			assert.True(t, errors.Is(root.SetValue("k3", make(chan int)), proxy.ErrUnsupportedValue))
+			assert.True(t, errors.Is(
+				root.SetValue("k3", map[int]interface{}{1: "a"}),
+				proxy.ErrUnsupportedValue,
+			))
+			assert.NotNil(t, root.SetFromJSON("k3", []byte(`{"a":`)))
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Len(t, doc1.CreateChangePack().Changes, changes)
+	})
+
+	t.Run("struct binding test", func(t *testing.T) {
+		type todo struct {
+			Title string `yorkie:"title,text"`
+			Done  bool   `yorkie:"done"`
+		}
+		type board struct {
+			Name    string            `yorkie:"name"`
+			Todos   []todo            `yorkie:"todos"`
+			Tags    []string          `yorkie:"tags,omitempty"`
+			Labels  map[string]string `yorkie:"labels"`
+			Ignored string            `yorkie:"-"`
+		}
+
+		doc := document.New("c1", "d1")
+		b := board{
+			Name:   "board",
+			Todos:  []todo{{Title: "Hello"}, {Title: "World"}},
+			Tags:   []string{"a", "b"},
+			Labels: map[string]string{"k": "v"},
+		}
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			return root.Encode(b)
+		})
+		assert.Nil(t, err)
+		assert.Equal(
+			t,
+			`{"labels":{"k":"v"},"name":"board","tags":["a","b"],"todos":[{"done":false,"title":"Hello"},{"done":false,"title":"World"}]}`,
+			doc.Marshal(),
+		)
+
+		doc2 := document.New("c1", "d1")
+		syncDocument(t, doc, doc2)
+
+		b.Todos[0].Done = true
+		b.Todos[1].Title = "World!"
+		b.Todos = append(b.Todos, todo{Title: "New"})
+		b.Tags = []string{"x", "a", "b"}
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			return root.Encode(b)
+		})
+		assert.Nil(t, err)
+
+		// NOTE: only the changed parts are pushed as operations.
+		changes := doc.CreateChangePack().Changes
+		ops := changes[len(changes)-1].Operations()
+		assert.Len(t, ops, 4)
+		assert.IsType(t, &operation.Add{}, ops[0])
+		assert.IsType(t, &operation.Set{}, ops[1])
+		assert.IsType(t, &operation.Edit{}, ops[2])
+		assert.IsType(t, &operation.Add{}, ops[3])
+
+		b.Tags = nil
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			return root.Encode(b)
+		})
+		assert.Nil(t, err)
+		assert.Equal(
+			t,
+			`{"labels":{"k":"v"},"name":"board","todos":[{"done":true,"title":"Hello"},{"done":false,"title":"World!"},{"done":false,"title":"New"}]}`,
+			doc.Marshal(),
+		)
+
+		pbPack, err := converter.ToChangePack(doc.CreateChangePack())
+		assert.Nil(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.Nil(t, err)
+		assert.Nil(t, doc2.ApplyChangePack(pack))
+		assert.Equal(t, doc.Marshal(), doc2.Marshal())
+
+		decoded := board{Ignored: "kept"}
+		assert.Nil(t, document.Decode(doc, &decoded))
+		b.Ignored = "kept"
+		assert.Equal(t, b, decoded)
+
+		assert.True(t, errors.Is(document.Decode(doc, decoded), document.ErrInvalidDecodeTarget))
+		var wrong struct {
+			Name int `yorkie:"name"`
+		}
+		assert.True(t, errors.Is(document.Decode(doc, &wrong), json.ErrUnexpectedType))
+	})
+
+	t.Run("struct binding of numbers and dates test", func(t *testing.T) {
+		type event struct {
+			Count int64      `yorkie:"count"`
+			Total int        `yorkie:"total"`
+			At    time2.Time `yorkie:"at"`
+		}
+
+		doc := document.New("c1", "d1")
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("count", 5)
+			return nil
+		})
+		assert.Nil(t, err)
+
+		e := event{Count: 5, Total: 1 << 40, At: time2.Unix(100, 0)}
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			return root.Encode(e)
+		})
+		assert.Nil(t, err)
+		syncDocument(t, doc, document.New("c1", "d1"))
+
+		// NOTE: the same values are not set again regardless of their types.
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			return root.Encode(e)
+		})
+		assert.Nil(t, err)
+		assert.False(t, doc.HasLocalChanges())
+
+		e.At = e.At.Add(500 * time2.Millisecond)
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			return root.Encode(e)
+		})
+		assert.Nil(t, err)
+		assert.True(t, doc.HasLocalChanges())
+	})
+
+	t.Run("marshal test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString(`k"1`, "a\"b\\c\nd\x01\u2028")
+			root.SetNewArray("k2").AddDouble(math.NaN()).AddDate(time2.Unix(0, 0).UTC())
+			root.SetNewText("k3").Edit(0, 0, `"quoted"`)
+			return nil
+		})
+		assert.Nil(t, err)
+
+		expected := `{"k\"1":"a\"b\\c\nd\u0001\u2028","k2":[null,"1970-01-01T00:00:00Z"],"k3":"\"quoted\""}`
+		assert.Equal(t, expected, doc.Marshal())
+		assert.True(t, gojson.Valid([]byte(doc.Marshal())))
+
+		var buf bytes.Buffer
+		assert.Nil(t, doc.MarshalTo(&buf))
+		assert.Equal(t, expected, buf.String())
+
+		bytes, err := gojson.Marshal(map[string]interface{}{"doc": doc})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"doc":`+expected+`}`, string(bytes))
+
+		var decoded map[string]interface{}
+		assert.Nil(t, gojson.Unmarshal([]byte(doc.Marshal()), &decoded))
+		assert.Equal(t, "a\"b\\c\nd\x01\u2028", decoded[`k"1`])
+	})
+
 	t.Run("rollback test", func(t *testing.T) {
 		doc := document.New("c1", "d1")
 
@@ -200,3 +1851,29 @@ func TestDocument(t *testing.T) {
 		assert.Equal(t, `{"k1":[1,2,3,4,5]}`, doc.Marshal())
 	})
 }
+
+// syncDocument delivers the local changes of the given from document to the
+// given to document without the agent.
+func syncDocument(t *testing.T, from, to *document.Document) {
+	pack := from.CreateChangePack()
+	assert.Nil(t, to.ApplyChangePack(change.NewPack(
+		pack.DocumentKey,
+		checkpoint.Initial,
+		pack.Changes,
+	)))
+	assert.Nil(t, from.ApplyChangePack(change.NewPack(
+		pack.DocumentKey,
+		pack.Checkpoint,
+		nil,
+	)))
+}
+
+// encodeSnapshot encodes the snapshot of the given document and decodes it
+// again so that the result does not share the root with the document.
+func encodeSnapshot(t *testing.T, doc *document.Document) *change.Pack {
+	bytes, err := converter.SnapshotToBytes(doc.Snapshot())
+	assert.Nil(t, err)
+	pack, err := converter.BytesToSnapshot(bytes)
+	assert.Nil(t, err)
+	return pack
+}
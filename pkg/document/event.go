@@ -0,0 +1,222 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operation"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// ChangeEventType is the type of the operation that changed the document.
+type ChangeEventType string
+
+const (
+	// SetEvent means that a value of an object has been set.
+	SetEvent ChangeEventType = "set"
+
+	// AddEvent means that a value has been added to an array.
+	AddEvent ChangeEventType = "add"
+
+	// MoveEvent means that a value of an array has been moved.
+	MoveEvent ChangeEventType = "move"
+
+	// RemoveEvent means that a value has been removed.
+	RemoveEvent ChangeEventType = "remove"
+
+	// EditEvent means that the content of a text has been edited.
+	EditEvent ChangeEventType = "edit"
+
+	// StyleEvent means that the attributes of a text have been changed.
+	StyleEvent ChangeEventType = "style"
+
+	// SelectEvent means that the selection of a text has been changed.
+	SelectEvent ChangeEventType = "select"
+
+	// IncreaseEvent means that a counter has been increased.
+	IncreaseEvent ChangeEventType = "increase"
+
+	// SnapshotEvent means that the whole document has been replaced by a
+	// snapshot.
+	SnapshotEvent ChangeEventType = "snapshot"
+)
+
+// ChangeEvent represents a change of the document made by an operation.
+type ChangeEvent struct {
+	Type ChangeEventType
+
+	// Path is the path of the changed value from the root, e.g. $.k1[0].
+	Path string
+
+	// OldValue and NewValue are the JSON encoding of the value before and
+	// after the change. They are empty if there is no value, and for
+	// EditEvent whose changes are in TextChanges.
+	OldValue string
+	NewValue string
+
+	// TextChanges are the changes of the text in indexes. It is only set for
+	// EditEvent, so that editors can apply them without the whole content.
+	TextChanges []*json.TextChange
+
+	// IsLocal is whether the change is made by this document or not.
+	IsLocal bool
+
+	// Actor is the actor who made the change.
+	Actor *time.ActorID
+}
+
+// EventHandler is a function that handles the change events of the document.
+type EventHandler func(event *ChangeEvent)
+
+// Subscribe registers the given handler to receive the change events of this
+// document. It returns a function to unsubscribe.
+func (d *Document) Subscribe(handler EventHandler) func() {
+	d.handlerID++
+	id := d.handlerID
+	d.handlers[id] = handler
+
+	return func() {
+		delete(d.handlers, id)
+	}
+}
+
+// executeChange applies the given change to the root and publishes the events
+// of its operations to the subscribers.
+func (d *Document) executeChange(c *change.Change, isLocal bool) error {
+	if len(d.handlers) == 0 {
+		return c.Execute(d.root)
+	}
+
+	for _, op := range c.Operations() {
+		event := d.beforeExecute(op)
+
+		var textChanges []*json.TextChange
+		if edit, ok := op.(*operation.Edit); ok {
+			changes, err := edit.ExecuteWithChanges(d.root)
+			if err != nil {
+				return err
+			}
+			textChanges = changes
+		} else if err := op.Execute(d.root); err != nil {
+			return err
+		}
+
+		if event != nil && d.afterExecute(op, event) {
+			event.TextChanges = textChanges
+			event.IsLocal = isLocal
+			event.Actor = op.ExecutedAt().ActorID()
+			d.publish(event)
+		}
+	}
+
+	return nil
+}
+
+// beforeExecute creates the event of the given operation with the state of
+// the root before executing it. It returns nil if the operation changes
+// nothing visible, e.g. the parent has been removed.
+func (d *Document) beforeExecute(op operation.Operation) *ChangeEvent {
+	parentPath, ok := d.root.FindPath(op.ParentCreatedAt())
+	if !ok {
+		return nil
+	}
+	parent := d.root.FindByCreatedAt(op.ParentCreatedAt())
+
+	switch op := op.(type) {
+	case *operation.Set:
+		event := &ChangeEvent{Type: SetEvent, Path: json.MemberPath(parentPath, op.Key())}
+		if value := parent.(*json.Object).Get(op.Key()); value != nil {
+			event.OldValue = value.Marshal()
+		}
+		return event
+	case *operation.Add:
+		return &ChangeEvent{Type: AddEvent}
+	case *operation.Move:
+		return &ChangeEvent{Type: MoveEvent}
+	case *operation.Remove:
+		path, ok := d.root.FindPath(op.CreatedAt())
+		if !ok {
+			return nil
+		}
+		return &ChangeEvent{
+			Type:     RemoveEvent,
+			Path:     path,
+			OldValue: d.root.FindByCreatedAt(op.CreatedAt()).Marshal(),
+		}
+	case *operation.Edit:
+		return &ChangeEvent{Type: EditEvent, Path: parentPath}
+	case *operation.Style:
+		return &ChangeEvent{Type: StyleEvent, Path: parentPath, OldValue: marshalWithAttrs(parent)}
+	case *operation.Select:
+		return &ChangeEvent{Type: SelectEvent, Path: parentPath}
+	case *operation.Increase:
+		return &ChangeEvent{Type: IncreaseEvent, Path: parentPath, OldValue: parent.Marshal()}
+	}
+
+	return nil
+}
+
+// afterExecute fills the given event with the state of the root after
+// executing the given operation. It returns false if the event should not be
+// published.
+func (d *Document) afterExecute(op operation.Operation, event *ChangeEvent) bool {
+	switch op := op.(type) {
+	case *operation.Set:
+		if value := d.root.FindByCreatedAt(op.ParentCreatedAt()).(*json.Object).Get(op.Key()); value != nil {
+			event.NewValue = value.Marshal()
+		}
+	case *operation.Add:
+		return d.fillLiveValue(op.Value().CreatedAt(), event)
+	case *operation.Move:
+		return d.fillLiveValue(op.CreatedAt(), event)
+	case *operation.Increase:
+		event.NewValue = d.root.FindByCreatedAt(op.ParentCreatedAt()).Marshal()
+	case *operation.Style:
+		event.NewValue = marshalWithAttrs(d.root.FindByCreatedAt(op.ParentCreatedAt()))
+	}
+
+	return true
+}
+
+// fillLiveValue fills the path and the new value of the given event with the
+// element of the given creation time. It returns false if the element is not
+// visible.
+func (d *Document) fillLiveValue(createdAt *time.Ticket, event *ChangeEvent) bool {
+	path, ok := d.root.FindPath(createdAt)
+	if !ok {
+		return false
+	}
+
+	event.Path = path
+	event.NewValue = d.root.FindByCreatedAt(createdAt).Marshal()
+	return true
+}
+
+func (d *Document) publish(event *ChangeEvent) {
+	for _, handler := range d.handlers {
+		handler(event)
+	}
+}
+
+func marshalWithAttrs(elem json.Element) string {
+	if text, ok := elem.(*json.Text); ok {
+		return text.MarshalWithAttrs()
+	}
+
+	return elem.Marshal()
+}
@@ -0,0 +1,178 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+var (
+	// ErrInvalidAnchor is returned when the given anchor can not be parsed.
+	ErrInvalidAnchor = errors.New("invalid anchor")
+
+	// ErrAnchorNotFound is returned when the node of the given anchor has
+	// been purged from the text.
+	ErrAnchorNotFound = errors.New("fail to find the anchor")
+
+	// ErrIndexOutOfRange is returned when the given index is out of the range
+	// of the text.
+	ErrIndexOutOfRange = errors.New("index out of range")
+)
+
+// Gravity decides which side of an anchor the content inserted at the anchor
+// goes to.
+type Gravity int
+
+const (
+	// LeftGravity sticks the anchor to the character before it. Content
+	// inserted at the anchor goes after the anchor.
+	LeftGravity Gravity = iota
+
+	// RightGravity sticks the anchor to the character after it. Content
+	// inserted at the anchor goes before the anchor.
+	RightGravity
+)
+
+// String returns the string representation of this gravity.
+func (g Gravity) String() string {
+	if g == RightGravity {
+		return "R"
+	}
+	return "L"
+}
+
+// TextAnchor is a position of a text that survives concurrent edits, e.g.
+// bookmarks and comment anchors. It can be stored as a string.
+type TextAnchor struct {
+	pos     *TextNodePos
+	gravity Gravity
+}
+
+// NewTextAnchor creates a new instance of TextAnchor.
+func NewTextAnchor(pos *TextNodePos, gravity Gravity) *TextAnchor {
+	return &TextAnchor{
+		pos:     NewTextNodePos(pos.getAbsoluteID(), 0),
+		gravity: gravity,
+	}
+}
+
+// ParseTextAnchor parses the given string created by TextAnchor.String.
+func ParseTextAnchor(str string) (*TextAnchor, error) {
+	parts := strings.Split(str, ":")
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAnchor, str)
+	}
+
+	lamport, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAnchor, str)
+	}
+	delimiter, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAnchor, str)
+	}
+	if decoded, err := hex.DecodeString(parts[2]); err != nil || len(decoded) != len(time.ActorID{}) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAnchor, str)
+	}
+	offset, err := strconv.Atoi(parts[3])
+	if err != nil || offset < 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAnchor, str)
+	}
+
+	var gravity Gravity
+	switch parts[4] {
+	case LeftGravity.String():
+		gravity = LeftGravity
+	case RightGravity.String():
+		gravity = RightGravity
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAnchor, str)
+	}
+
+	createdAt := time.NewTicket(lamport, uint32(delimiter), time.ActorIDFromHex(parts[2]))
+	return NewTextAnchor(NewTextNodePos(NewTextNodeID(createdAt, offset), 0), gravity), nil
+}
+
+// Pos returns the position of this anchor.
+func (a *TextAnchor) Pos() *TextNodePos {
+	return a.pos
+}
+
+// Gravity returns the gravity of this anchor.
+func (a *TextAnchor) Gravity() Gravity {
+	return a.gravity
+}
+
+// String returns the string representation of this anchor, e.g.
+// "3:1:000000000000000000000001:2:L".
+func (a *TextAnchor) String() string {
+	return fmt.Sprintf("%s:%d:%s", a.pos.id.createdAt.Key(), a.pos.id.offset, a.gravity)
+}
+
+// CreateAnchor returns the anchor of the given index. An anchor with left
+// gravity is attached after the character before the index, and an anchor
+// with right gravity is attached before the character at the index. At the
+// edges of the text, the anchor falls back to the side which has a character.
+func (t *Text) CreateAnchor(index int, gravity Gravity) (*TextAnchor, error) {
+	if index < 0 || index > utf8.RuneCountInString(t.String()) {
+		return nil, fmt.Errorf("%w: %d", ErrIndexOutOfRange, index)
+	}
+
+	if gravity == RightGravity {
+		if pos := t.rgaTreeSplit.findTextNodePosPreferToRight(index); pos != nil {
+			return NewTextAnchor(pos, RightGravity), nil
+		}
+	}
+
+	return NewTextAnchor(t.rgaTreeSplit.findTextNodePos(index), LeftGravity), nil
+}
+
+// ResolveAnchor returns the current index of the given anchor. If the
+// character of the anchor has been deleted, the index where it was is
+// returned.
+func (t *Text) ResolveAnchor(anchor *TextAnchor) (int, error) {
+	index, ok := t.rgaTreeSplit.findIndex(anchor.pos, anchor.gravity)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrAnchorNotFound, anchor)
+	}
+
+	return index, nil
+}
+
+// findTextNodePosPreferToRight returns the position of the character at the
+// given index. It returns nil if there is no character at the index.
+func (s *RGATreeSplit) findTextNodePosPreferToRight(index int) *TextNodePos {
+	splayNode, offset := s.treeByIndex.Find(index)
+	textNode := splayNode.Value().(*TextNode)
+
+	for offset == textNode.Len() {
+		textNode = textNode.next
+		if textNode == nil {
+			return nil
+		}
+		offset = 0
+	}
+
+	return NewTextNodePos(textNode.ID(), offset)
+}
@@ -17,6 +17,8 @@
 package json
 
 import (
+	"bufio"
+
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
@@ -70,17 +72,41 @@ func (a *Array) Elements() []Element {
 	return elements
 }
 
+// Nodes returns the nodes of this Array including deleted ones.
+func (a *Array) Nodes() []*RGANode {
+	return a.elements.Nodes()
+}
+
+// AllNodes returns the nodes of this Array including the positions that
+// elements have moved away from.
+func (a *Array) AllNodes() []*RGANode {
+	return a.elements.AllNodes()
+}
+
 // Marshal returns the JSON encoding of this Array.
 func (a *Array) Marshal() string {
-	return a.elements.Marshal()
+	return marshal(a)
+}
+
+// MarshalJSON returns the JSON encoding of this Array.
+func (a *Array) MarshalJSON() ([]byte, error) {
+	return []byte(a.Marshal()), nil
+}
+
+func (a *Array) writeJSON(w *bufio.Writer) {
+	a.elements.writeJSON(w)
 }
 
 // Deepcopy copies itself deeply.
 func (a *Array) Deepcopy() Element {
 	elements := NewRGA()
 
-	for _, node := range a.elements.Nodes() {
-		elements.Add(node.elem.Deepcopy())
+	for _, node := range a.elements.AllNodes() {
+		if node.elem == nil {
+			elements.AddMovedAway(node.positionedAt, node.movedAwayAt)
+			continue
+		}
+		elements.AddWithMovedAt(node.elem.Deepcopy(), node.MovedAt())
 	}
 
 	array := NewArray(elements, a.createdAt)
@@ -103,14 +129,36 @@ func (a *Array) Delete(deletedAt *time.Ticket) {
 	a.deletedAt = deletedAt
 }
 
+// FirstCreatedAt returns the creation time of the head of this Array.
+func (a *Array) FirstCreatedAt() *time.Ticket {
+	return a.elements.FirstCreatedAt()
+}
+
 // LastCreatedAt returns the creation time of the last element.
 func (a *Array) LastCreatedAt() *time.Ticket {
 	return a.elements.LastCreatedAt()
 }
 
-// InsertAfter inserts the given element after the given previous element.
-func (a *Array) InsertAfter(prevCreatedAt *time.Ticket, element Element) {
-	a.elements.InsertAfter(prevCreatedAt, element)
+// PositionedAt returns the time when the given element was placed at its
+// current position.
+func (a *Array) PositionedAt(createdAt *time.Ticket) *time.Ticket {
+	return a.elements.PositionedAt(createdAt)
+}
+
+// InsertAfter inserts the given element after the given previous position.
+func (a *Array) InsertAfter(prevPositionedAt *time.Ticket, element Element) error {
+	return a.elements.InsertAfter(prevPositionedAt, element)
+}
+
+// MoveAfter moves the given element after the given previous position.
+func (a *Array) MoveAfter(prevPositionedAt, createdAt, executedAt *time.Ticket) error {
+	return a.elements.MoveAfter(prevPositionedAt, createdAt, executedAt)
+}
+
+// PrevCreatedAt returns the creation time of the live element before the
+// given element, skipping the given except element.
+func (a *Array) PrevCreatedAt(createdAt, exceptCreatedAt *time.Ticket) (*time.Ticket, error) {
+	return a.elements.PrevCreatedAt(createdAt, exceptCreatedAt)
 }
 
 // RemoveByCreatedAt removes the given element.
@@ -123,14 +171,30 @@ func (a *Array) Len() int {
 	return a.elements.Len()
 }
 
-func (a *Array) Descendants(descendants chan Element) {
+// Purge physically purges the given child element.
+func (a *Array) Purge(child Element) {
+	a.elements.purge(child)
+}
+
+// GarbageCollect physically purges the positions that elements moved away
+// from before the given time.
+func (a *Array) GarbageCollect(ticket *time.Ticket) int {
+	return a.elements.garbageCollect(ticket)
+}
+
+// GarbageLen returns the number of the positions that elements moved away
+// from.
+func (a *Array) GarbageLen() int {
+	return a.elements.garbageLen()
+}
+
+// Descendants traverses the descendants of this array.
+func (a *Array) Descendants(callback func(elem Element, parent Container)) {
 	for _, node := range a.elements.Nodes() {
-		switch elem := node.elem.(type) {
-		case *Object:
-			elem.Descendants(descendants)
-		case *Array:
-			elem.Descendants(descendants)
+		callback(node.elem, a)
+
+		if elem, ok := node.elem.(Container); ok {
+			elem.Descendants(callback)
 		}
-		descendants <- node.elem
 	}
 }
@@ -0,0 +1,174 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// Counter represents changeable number data type. Unlike Primitive, the value
+// of Counter is changed by Increase operations, which are commutative, so
+// concurrent increments from different replicas are not lost.
+type Counter struct {
+	valueType ValueType
+	value     interface{}
+	createdAt *time.Ticket
+	deletedAt *time.Ticket
+}
+
+// NewCounter creates a new instance of Counter.
+func NewCounter(value interface{}, createdAt *time.Ticket) *Counter {
+	switch val := value.(type) {
+	case int:
+		return &Counter{
+			valueType: Integer,
+			value:     val,
+			createdAt: createdAt,
+		}
+	case int64:
+		return &Counter{
+			valueType: Long,
+			value:     val,
+			createdAt: createdAt,
+		}
+	case float64:
+		return &Counter{
+			valueType: Double,
+			value:     val,
+			createdAt: createdAt,
+		}
+	}
+
+	panic("unsupported type")
+}
+
+// Bytes creates an array representing the value.
+func (c *Counter) Bytes() []byte {
+	switch val := c.value.(type) {
+	case int:
+		bytes := [4]byte{}
+		binary.LittleEndian.PutUint32(bytes[:], uint32(val))
+		return bytes[:]
+	case int64:
+		bytes := [8]byte{}
+		binary.LittleEndian.PutUint64(bytes[:], uint64(val))
+		return bytes[:]
+	case float64:
+		bytes := [8]byte{}
+		binary.LittleEndian.PutUint64(bytes[:], math.Float64bits(val))
+		return bytes[:]
+	}
+
+	panic("unsupported type")
+}
+
+// Marshal returns the JSON encoding of the value.
+func (c *Counter) Marshal() string {
+	switch c.valueType {
+	case Integer:
+		return fmt.Sprintf("%d", c.value)
+	case Long:
+		return fmt.Sprintf("%d", c.value)
+	case Double:
+		return formatDouble(c.value.(float64))
+	}
+
+	panic("unsupported type")
+}
+
+// MarshalJSON returns the JSON encoding of the value.
+func (c *Counter) MarshalJSON() ([]byte, error) {
+	return []byte(c.Marshal()), nil
+}
+
+// Deepcopy copies itself deeply.
+func (c *Counter) Deepcopy() Element {
+	counter := *c
+	return &counter
+}
+
+// CreatedAt returns the creation time.
+func (c *Counter) CreatedAt() *time.Ticket {
+	return c.createdAt
+}
+
+// DeletedAt returns the deletion time of this element.
+func (c *Counter) DeletedAt() *time.Ticket {
+	return c.deletedAt
+}
+
+// Delete deletes this element.
+func (c *Counter) Delete(deletedAt *time.Ticket) {
+	c.deletedAt = deletedAt
+}
+
+// ValueType returns the type of the value.
+func (c *Counter) ValueType() ValueType {
+	return c.valueType
+}
+
+// Value returns the value of this counter.
+func (c *Counter) Value() interface{} {
+	return c.value
+}
+
+// Increase increases the value of this counter by the value of the given
+// primitive. The given value is converted to the type of this counter.
+func (c *Counter) Increase(v *Primitive) *Counter {
+	switch c.valueType {
+	case Integer:
+		c.value = c.value.(int) + int(int64Of(v))
+	case Long:
+		c.value = c.value.(int64) + int64Of(v)
+	case Double:
+		c.value = c.value.(float64) + float64Of(v)
+	default:
+		panic("unsupported type")
+	}
+
+	return c
+}
+
+func int64Of(p *Primitive) int64 {
+	switch val := p.value.(type) {
+	case int:
+		return int64(val)
+	case int64:
+		return val
+	case float64:
+		return int64(val)
+	}
+
+	panic("unsupported type")
+}
+
+func float64Of(p *Primitive) float64 {
+	switch val := p.value.(type) {
+	case int:
+		return float64(val)
+	case int64:
+		return float64(val)
+	case float64:
+		return val
+	}
+
+	panic("unsupported type")
+}
@@ -25,6 +25,10 @@ type Element interface {
 	// Marshal returns the JSON encoding of this element.
 	Marshal() string
 
+	// MarshalJSON returns the JSON encoding of this element. It implements
+	// encoding/json.Marshaler.
+	MarshalJSON() ([]byte, error)
+
 	// Deepcopy copies itself deeply.
 	Deepcopy() Element
 
@@ -37,3 +41,42 @@ type Element interface {
 	// Delete deletes this element.
 	Delete(*time.Ticket)
 }
+
+// Container represents Array or Object.
+type Container interface {
+	Element
+
+	// Purge physically purges the given child element.
+	Purge(child Element)
+
+	// Descendants traverses the descendants of this container.
+	Descendants(callback func(elem Element, parent Container))
+}
+
+// ValueOf returns the Go value of the given element. Objects and arrays are
+// converted into map[string]interface{} and []interface{}, and texts are
+// converted into strings.
+func ValueOf(elem Element) interface{} {
+	switch elem := elem.(type) {
+	case *Object:
+		value := make(map[string]interface{})
+		for key, member := range elem.Members() {
+			value[key] = ValueOf(member)
+		}
+		return value
+	case *Array:
+		value := make([]interface{}, 0, elem.Len())
+		for _, element := range elem.Elements() {
+			value = append(value, ValueOf(element))
+		}
+		return value
+	case *Text:
+		return elem.String()
+	case *Counter:
+		return elem.Value()
+	case *Primitive:
+		return elem.Value()
+	}
+
+	panic("unsupported type")
+}
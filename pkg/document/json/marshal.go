@@ -0,0 +1,138 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+const hexDigits = "0123456789abcdef"
+
+// jsonWriter is implemented by the elements to write their JSON encoding.
+type jsonWriter interface {
+	writeJSON(w *bufio.Writer)
+}
+
+// MarshalTo writes the JSON encoding of the given element to the given
+// writer. The members of objects are written in the order of keys.
+func MarshalTo(elem Element, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	writeJSON(bw, elem)
+	return bw.Flush()
+}
+
+// marshal returns the JSON encoding of the given element.
+func marshal(elem Element) string {
+	sb := strings.Builder{}
+
+	// NOTE: strings.Builder never returns an error.
+	_ = MarshalTo(elem, &sb)
+	return sb.String()
+}
+
+// quote returns the given string as a JSON string.
+func quote(s string) string {
+	sb := strings.Builder{}
+	bw := bufio.NewWriter(&sb)
+	writeString(bw, s)
+	_ = bw.Flush()
+	return sb.String()
+}
+
+// formatDouble returns the JSON encoding of the given double. NaN and
+// infinities are encoded as null because JSON can not represent them.
+func formatDouble(v float64) string {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return "null"
+	}
+
+	return fmt.Sprintf("%f", v)
+}
+
+func writeJSON(w *bufio.Writer, elem Element) {
+	if writer, ok := elem.(jsonWriter); ok {
+		writer.writeJSON(w)
+		return
+	}
+
+	_, _ = w.WriteString(elem.Marshal())
+}
+
+// writeString writes the given string as a JSON string. Quotes, backslashes
+// and control characters are escaped, and invalid UTF-8 is replaced with
+// U+FFFD.
+func writeString(w *bufio.Writer, s string) {
+	_ = w.WriteByte('"')
+
+	start := 0
+	for i := 0; i < len(s); {
+		if b := s[i]; b < utf8.RuneSelf {
+			if b >= 0x20 && b != '"' && b != '\\' {
+				i++
+				continue
+			}
+
+			_, _ = w.WriteString(s[start:i])
+			switch b {
+			case '"', '\\':
+				_ = w.WriteByte('\\')
+				_ = w.WriteByte(b)
+			case '\n':
+				_, _ = w.WriteString(`\n`)
+			case '\r':
+				_, _ = w.WriteString(`\r`)
+			case '\t':
+				_, _ = w.WriteString(`\t`)
+			default:
+				_, _ = w.WriteString(`\u00`)
+				_ = w.WriteByte(hexDigits[b>>4])
+				_ = w.WriteByte(hexDigits[b&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+
+		c, size := utf8.DecodeRuneInString(s[i:])
+		if c == utf8.RuneError && size == 1 {
+			_, _ = w.WriteString(s[start:i])
+			_, _ = w.WriteString(`\ufffd`)
+			i += size
+			start = i
+			continue
+		}
+
+		// NOTE: U+2028 and U+2029 are valid in JSON but not in JavaScript.
+		if c == '\u2028' || c == '\u2029' {
+			_, _ = w.WriteString(s[start:i])
+			_, _ = w.WriteString(`\u202`)
+			_ = w.WriteByte(hexDigits[c&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+
+	_, _ = w.WriteString(s[start:])
+	_ = w.WriteByte('"')
+}
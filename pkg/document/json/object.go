@@ -17,9 +17,8 @@
 package json
 
 import (
-	"fmt"
+	"bufio"
 	"sort"
-	"strings"
 
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -40,9 +39,25 @@ func NewObject(memberNodes *RHT, createdAt *time.Ticket) *Object {
 	}
 }
 
-// Set sets the given element of the given key.
-func (o *Object) Set(k string, v Element) {
-	o.memberNodes.Set(k, v)
+// Set sets the given element of the given key. It returns the elements that
+// are no longer visible because of this set.
+func (o *Object) Set(k string, v Element) []Element {
+	return o.memberNodes.Set(k, v)
+}
+
+// SetWithObserved sets the given element of the given key by a write that has
+// observed the values of the given creation times. The values that have not
+// been observed are kept as concurrent values. It returns the elements that
+// are no longer visible because of this set.
+func (o *Object) SetWithObserved(k string, v Element, observed []*time.Ticket) []Element {
+	return o.memberNodes.SetWithObserved(k, v, observed)
+}
+
+// Conflicts returns the live values of the given key including the ones
+// written concurrently, newest first. The actor and the time of each write
+// can be found from the creation time of the value.
+func (o *Object) Conflicts(k string) []Element {
+	return o.memberNodes.Conflicts(k)
 }
 
 // Members returns the member of this object as a map.
@@ -65,49 +80,63 @@ func (o *Object) RemoveByCreatedAt(createdAt *time.Ticket, deletedAt *time.Ticke
 	return o.memberNodes.RemoveByCreatedAt(createdAt, deletedAt)
 }
 
-// Remove removes the element of the given key.
-func (o *Object) Remove(k string, deletedAt *time.Ticket) Element {
+// Remove removes the elements of the given key including the ones written
+// concurrently. It returns the removed elements, newest first.
+func (o *Object) Remove(k string, deletedAt *time.Ticket) []Element {
 	return o.memberNodes.Remove(k, deletedAt)
 }
 
-func (o *Object) Descendants(descendants chan Element) {
+// RHTNodes returns the nodes of this Object including deleted ones.
+func (o *Object) RHTNodes() []*RHTNode {
+	return o.memberNodes.AllNodes()
+}
+
+// Purge physically purges the given child element.
+func (o *Object) Purge(child Element) {
+	o.memberNodes.purge(child)
+}
+
+// Descendants traverses the descendants of this object.
+func (o *Object) Descendants(callback func(elem Element, parent Container)) {
 	for _, node := range o.memberNodes.AllNodes() {
-		switch elem := node.elem.(type) {
-		case *Object:
-			elem.Descendants(descendants)
-		case *Array:
-			elem.Descendants(descendants)
+		callback(node.elem, o)
+
+		if elem, ok := node.elem.(Container); ok {
+			elem.Descendants(callback)
 		}
-		descendants <- node.elem
 	}
 }
 
 // Marshal returns the JSON encoding of this object.
 func (o *Object) Marshal() string {
+	return marshal(o)
+}
+
+// MarshalJSON returns the JSON encoding of this object.
+func (o *Object) MarshalJSON() ([]byte, error) {
+	return []byte(o.Marshal()), nil
+}
+
+// writeJSON writes the JSON encoding of this object in the order of keys.
+func (o *Object) writeJSON(w *bufio.Writer) {
 	members := o.memberNodes.Elements()
 
-	size := len(members)
-	keys := make([]string, 0, size)
+	keys := make([]string, 0, len(members))
 	for k := range members {
 		keys = append(keys, k)
 	}
 	sort.Strings(keys)
 
-	sb := strings.Builder{}
-	sb.WriteString("{")
-
-	idx := 0
-	for _, k := range keys {
-		value := members[k]
-		sb.WriteString(fmt.Sprintf("\"%s\":%s", k, value.Marshal()))
-		if size-1 != idx {
-			sb.WriteString(",")
+	_ = w.WriteByte('{')
+	for idx, k := range keys {
+		if idx > 0 {
+			_ = w.WriteByte(',')
 		}
-		idx++
+		writeString(w, k)
+		_ = w.WriteByte(':')
+		writeJSON(w, members[k])
 	}
-	sb.WriteString("}")
-
-	return sb.String()
+	_ = w.WriteByte('}')
 }
 
 // Deepcopy copies itself deeply.
@@ -115,7 +144,7 @@ func (o *Object) Deepcopy() Element {
 	members := NewRHT()
 
 	for _, node := range o.memberNodes.AllNodes() {
-		members.Set(node.key, node.elem.Deepcopy())
+		members.set(node.key, node.elem.Deepcopy())
 	}
 
 	obj := NewObject(members, o.createdAt)
@@ -0,0 +1,250 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+var (
+	// ErrInvalidPath is returned when the given path can not be parsed.
+	ErrInvalidPath = errors.New("invalid path")
+
+	// ErrPathNotFound is returned when there is no element of the given path.
+	ErrPathNotFound = errors.New("fail to find the path")
+
+	// ErrUnexpectedType is returned when the element of the given path is not
+	// the expected type.
+	ErrUnexpectedType = errors.New("unexpected type of the element")
+)
+
+// identifierRegexp is the pattern of the keys that can be written in a path
+// with dot notation.
+var identifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// PathToken is a step of a path. It is either a key of an object or an index
+// of an array.
+type PathToken struct {
+	Key     string
+	Index   int
+	IsIndex bool
+}
+
+// String returns the string representation of this token in a path.
+func (t PathToken) String() string {
+	if t.IsIndex {
+		return ElementPath("", t.Index)
+	}
+	return MemberPath("", t.Key)
+}
+
+// ParsePath parses the given JSONPath-style path such as $.todos[2].title or
+// $["a.b"][0] into tokens. The path should start with $ which means the root.
+func ParsePath(path string) ([]PathToken, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPath, path)
+	}
+
+	var tokens []PathToken
+	rest := path[1:]
+	for len(rest) > 0 {
+		var token PathToken
+		var err error
+		switch rest[0] {
+		case '.':
+			token, rest, err = parseDotKey(rest[1:])
+		case '[':
+			token, rest, err = parseBracket(rest[1:])
+		default:
+			err = ErrInvalidPath
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, path)
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+// MemberPath returns the path of the member of the given key in the object of
+// the given path.
+func MemberPath(path string, key string) string {
+	if identifierRegexp.MatchString(key) {
+		return path + "." + key
+	}
+
+	return fmt.Sprintf("%s[%s]", path, strconv.Quote(key))
+}
+
+// ElementPath returns the path of the element of the given index in the array
+// of the given path.
+func ElementPath(path string, index int) string {
+	return fmt.Sprintf("%s[%d]", path, index)
+}
+
+// FindByPath returns the element of the given path from the given element.
+// Removed elements are not found.
+func FindByPath(elem Element, path string) (Element, error) {
+	tokens, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, token := range tokens {
+		elem, err = FindByToken(elem, token)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, joinPath(tokens[:i+1]))
+		}
+	}
+
+	return elem, nil
+}
+
+// FindByToken returns the child of the given element by the given token.
+func FindByToken(elem Element, token PathToken) (Element, error) {
+	var child Element
+	switch elem := elem.(type) {
+	case *Object:
+		if token.IsIndex {
+			return nil, ErrUnexpectedType
+		}
+		child = elem.Get(token.Key)
+	case *Array:
+		if !token.IsIndex {
+			return nil, ErrUnexpectedType
+		}
+		child = elem.Get(token.Index)
+	default:
+		return nil, ErrUnexpectedType
+	}
+
+	if child == nil {
+		return nil, ErrPathNotFound
+	}
+
+	return child, nil
+}
+
+func joinPath(tokens []PathToken) string {
+	sb := strings.Builder{}
+	sb.WriteString("$")
+	for _, token := range tokens {
+		sb.WriteString(token.String())
+	}
+
+	return sb.String()
+}
+
+func parseDotKey(rest string) (PathToken, string, error) {
+	end := strings.IndexAny(rest, ".[")
+	if end < 0 {
+		end = len(rest)
+	}
+
+	key := rest[:end]
+	if !identifierRegexp.MatchString(key) {
+		return PathToken{}, "", ErrInvalidPath
+	}
+
+	return PathToken{Key: key}, rest[end:], nil
+}
+
+func parseBracket(rest string) (PathToken, string, error) {
+	if len(rest) > 0 && (rest[0] == '"' || rest[0] == '\'') {
+		return parseQuotedKey(rest)
+	}
+
+	end := strings.IndexByte(rest, ']')
+	if end < 0 {
+		return PathToken{}, "", ErrInvalidPath
+	}
+
+	index, err := strconv.Atoi(rest[:end])
+	if err != nil || index < 0 {
+		return PathToken{}, "", ErrInvalidPath
+	}
+
+	return PathToken{Index: index, IsIndex: true}, rest[end+1:], nil
+}
+
+func parseQuotedKey(rest string) (PathToken, string, error) {
+	quote := rest[0]
+	for i := 1; i < len(rest); i++ {
+		if rest[i] == '\\' {
+			i++
+			continue
+		}
+		if rest[i] != quote {
+			continue
+		}
+		if i+1 >= len(rest) || rest[i+1] != ']' {
+			return PathToken{}, "", ErrInvalidPath
+		}
+
+		quoted := rest[:i+1]
+		if quote == '\'' {
+			quoted = strconv.Quote(strings.ReplaceAll(rest[1:i], `\'`, `'`))
+		}
+		key, err := strconv.Unquote(quoted)
+		if err != nil {
+			return PathToken{}, "", ErrInvalidPath
+		}
+
+		return PathToken{Key: key}, rest[i+2:], nil
+	}
+
+	return PathToken{}, "", ErrInvalidPath
+}
+
+// tokenOf returns the token of the child of the given creation time in the
+// given container. It returns false if the child is not visible in it.
+func tokenOf(parent Container, createdAt *time.Ticket) (PathToken, bool) {
+	switch parent := parent.(type) {
+	case *Object:
+		key, ok := parent.memberNodes.keyOf(createdAt)
+		if !ok {
+			return PathToken{}, false
+		}
+
+		member := parent.Get(key)
+		if member == nil || member.CreatedAt().Compare(createdAt) != 0 {
+			return PathToken{}, false
+		}
+		return PathToken{Key: key}, true
+	case *Array:
+		index := 0
+		for _, node := range parent.elements.Nodes() {
+			if node.isDeleted() {
+				continue
+			}
+			if node.elem.CreatedAt().Compare(createdAt) == 0 {
+				return PathToken{Index: index, IsIndex: true}, true
+			}
+			index++
+		}
+	}
+
+	return PathToken{}, false
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+)
+
+func TestPath(t *testing.T) {
+	t.Run("parse path test", func(t *testing.T) {
+		tokens, err := json.ParsePath(`$.todos[2]["a.b"]['c"d'].title`)
+		assert.Nil(t, err)
+		assert.Equal(t, []json.PathToken{
+			{Key: "todos"},
+			{Index: 2, IsIndex: true},
+			{Key: "a.b"},
+			{Key: `c"d`},
+			{Key: "title"},
+		}, tokens)
+
+		tokens, err = json.ParsePath("$")
+		assert.Nil(t, err)
+		assert.Len(t, tokens, 0)
+
+		for _, path := range []string{"todos", "$.", "$[-1]", "$[a]", `$["a]`, "$.a b"} {
+			_, err := json.ParsePath(path)
+			assert.True(t, errors.Is(err, json.ErrInvalidPath), path)
+		}
+	})
+
+	t.Run("member path test", func(t *testing.T) {
+		assert.Equal(t, "$.k1", json.MemberPath("$", "k1"))
+		assert.Equal(t, `$["k.1"]`, json.MemberPath("$", "k.1"))
+		assert.Equal(t, "$[0]", json.ElementPath("$", 0))
+	})
+}
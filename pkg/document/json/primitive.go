@@ -17,6 +17,7 @@
 package json
 
 import (
+	"bufio"
 	"encoding/binary"
 	"fmt"
 	"math"
@@ -41,6 +42,8 @@ const (
 // ValueFromBytes parses the given bytes into value.
 func ValueFromBytes(valueType ValueType, value []byte) interface{} {
 	switch valueType {
+	case Null:
+		return nil
 	case Boolean:
 		if value[0] == 1 {
 			return true
@@ -76,6 +79,11 @@ type Primitive struct {
 // NewPrimitive creates a new instance of Primitive.
 func NewPrimitive(value interface{}, createdAt *time.Ticket) *Primitive {
 	switch val := value.(type) {
+	case nil:
+		return &Primitive{
+			valueType: Null,
+			createdAt: createdAt,
+		}
 	case bool:
 		return &Primitive{
 			valueType: Boolean,
@@ -126,6 +134,8 @@ func NewPrimitive(value interface{}, createdAt *time.Ticket) *Primitive {
 // Bytes creates an array representing the value.
 func (p *Primitive) Bytes() []byte {
 	switch val := p.value.(type) {
+	case nil:
+		return nil
 	case bool:
 		if val {
 			return []byte{1}
@@ -158,31 +168,41 @@ func (p *Primitive) Bytes() []byte {
 
 // Marshal returns the JSON encoding of the value.
 func (p *Primitive) Marshal() string {
+	return marshal(p)
+}
+
+// MarshalJSON returns the JSON encoding of the value.
+func (p *Primitive) MarshalJSON() ([]byte, error) {
+	return []byte(p.Marshal()), nil
+}
+
+func (p *Primitive) writeJSON(w *bufio.Writer) {
 	switch p.valueType {
+	case Null:
+		_, _ = w.WriteString("null")
 	case Boolean:
-		return fmt.Sprintf("%t", p.value)
-	case Integer:
-		return fmt.Sprintf("%d", p.value)
-	case Long:
-		return fmt.Sprintf("%d", p.value)
+		_, _ = w.WriteString(fmt.Sprintf("%t", p.value))
+	case Integer, Long:
+		_, _ = w.WriteString(fmt.Sprintf("%d", p.value))
 	case Double:
-		return fmt.Sprintf("%f", p.value)
+		_, _ = w.WriteString(formatDouble(p.value.(float64)))
 	case String:
-		return fmt.Sprintf("\"%s\"", p.value)
+		writeString(w, p.value.(string))
 	case Bytes:
 		// TODO: JSON.stringify({a: new Uint8Array([1,2]), b: 2})
 		// {"a":{"0":1,"1":2},"b":2}
-		return fmt.Sprintf("\"%s\"", p.value)
+		writeString(w, string(p.value.([]byte)))
 	case Date:
-		return p.value.(time2.Time).Format(time2.RFC3339)
+		writeString(w, p.value.(time2.Time).Format(time2.RFC3339))
+	default:
+		panic("unsupported type")
 	}
-
-	panic("unsupported type")
 }
 
 // Deepcopy copies itself deeply.
 func (p *Primitive) Deepcopy() Element {
-	return p
+	primitive := *p
+	return &primitive
 }
 
 // CreatedAt returns the creation time.
@@ -204,3 +224,8 @@ func (p *Primitive) Delete(deletedAt *time.Ticket) {
 func (p *Primitive) ValueType() ValueType {
 	return p.valueType
 }
+
+// Value returns the value of this Primitive.
+func (p *Primitive) Value() interface{} {
+	return p.value
+}
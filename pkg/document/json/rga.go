@@ -17,32 +17,79 @@
 package json
 
 import (
+	"bufio"
+	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/log"
 )
 
+var (
+	// ErrPositionNotFound is returned when the given position does not exist
+	// in RGA. It happens when the position has already been purged by garbage
+	// collection.
+	ErrPositionNotFound = errors.New("fail to find the given position")
+
+	// ErrElementNotFound is returned when the given element does not exist in
+	// RGA, e.g. it has never been inserted or has been purged.
+	ErrElementNotFound = errors.New("fail to find the given element")
+)
+
+// RGANode is a position of an element in RGA. When an element is moved,
+// it is placed at a new position and its previous position is kept as a
+// moved-away node, because concurrent operations may refer to it.
 type RGANode struct {
-	prev *RGANode
-	next *RGANode
-	elem Element
+	prev         *RGANode
+	next         *RGANode
+	elem         Element
+	positionedAt *time.Ticket
+	movedAwayAt  *time.Ticket
 }
 
 func (n *RGANode) isDeleted() bool {
-	return n.elem.DeletedAt() != nil
+	return n.elem == nil || n.elem.DeletedAt() != nil
+}
+
+// Element returns the element of this node. It is nil if the element has
+// moved away from this position.
+func (n *RGANode) Element() Element {
+	return n.elem
+}
+
+// PositionedAt returns the time when this position was created. It is the
+// creation time of the element unless the position was created by a move.
+func (n *RGANode) PositionedAt() *time.Ticket {
+	return n.positionedAt
+}
+
+// MovedAt returns the time when the element was moved to this position. It
+// is nil if the element has not been moved.
+func (n *RGANode) MovedAt() *time.Ticket {
+	if n.elem == nil || n.positionedAt.Compare(n.elem.CreatedAt()) == 0 {
+		return nil
+	}
+
+	return n.positionedAt
+}
+
+// MovedAwayAt returns the time when the element moved away from this
+// position.
+func (n *RGANode) MovedAwayAt() *time.Ticket {
+	return n.movedAwayAt
 }
 
 func newRGANode(elem Element) *RGANode {
 	return &RGANode{
-		prev: nil,
-		next: nil,
-		elem: elem,
+		prev:         nil,
+		next:         nil,
+		elem:         elem,
+		positionedAt: elem.CreatedAt(),
 	}
 }
 
-func newNodeAfter(prev *RGANode, elem Element) *RGANode {
-	newNode := newRGANode(elem)
+func linkNodeAfter(prev *RGANode, newNode *RGANode) *RGANode {
 	prevNext := prev.next
 
 	prev.next = newNode
@@ -57,87 +104,205 @@ func newNodeAfter(prev *RGANode, elem Element) *RGANode {
 
 // RGA is replicated growable array.
 type RGA struct {
-	nodeMapByCreatedAt map[string]*RGANode
-	first              *RGANode
-	last               *RGANode
-	size               int
+	nodeMapByCreatedAt             map[string]*RGANode
+	nodeMapByPositionedAt          map[string]*RGANode
+	movedAwayNodeMapByPositionedAt map[string]*RGANode
+	first                          *RGANode
+	last                           *RGANode
+	size                           int
 }
 
 // NewRGA creates a new instance of RGA.
 func NewRGA() *RGA {
-	nodeMapByCreatedAt := make(map[string]*RGANode)
 	dummyHead := newRGANode(NewPrimitive("", time.InitialTicket))
-	nodeMapByCreatedAt[dummyHead.elem.CreatedAt().Key()] = dummyHead
 
 	return &RGA{
-		nodeMapByCreatedAt: nodeMapByCreatedAt,
-		first:              dummyHead,
-		last:               dummyHead,
-		size:               0,
+		nodeMapByCreatedAt: map[string]*RGANode{
+			dummyHead.elem.CreatedAt().Key(): dummyHead,
+		},
+		nodeMapByPositionedAt: map[string]*RGANode{
+			dummyHead.positionedAt.Key(): dummyHead,
+		},
+		movedAwayNodeMapByPositionedAt: make(map[string]*RGANode),
+		first:                          dummyHead,
+		last:                           dummyHead,
+		size:                           0,
 	}
 }
 
 // Marshal returns the JSON encoding of this RGA.
 func (a *RGA) Marshal() string {
 	sb := strings.Builder{}
-	sb.WriteString("[")
+	bw := bufio.NewWriter(&sb)
+	a.writeJSON(bw)
+	_ = bw.Flush()
+
+	return sb.String()
+}
+
+func (a *RGA) writeJSON(w *bufio.Writer) {
+	_ = w.WriteByte('[')
 
 	idx := 0
-	current := a.first.next
-	for {
-		if current == nil {
-			break
+	for current := a.first.next; current != nil; current = current.next {
+		if current.isDeleted() {
+			continue
 		}
 
-		if !current.isDeleted() {
-			sb.WriteString(current.elem.Marshal())
-			if a.size-1 != idx {
-				sb.WriteString(",")
-			}
-			idx++
+		if idx > 0 {
+			_ = w.WriteByte(',')
 		}
-
-		current = current.next
+		writeJSON(w, current.elem)
+		idx++
 	}
 
-	sb.WriteString("]")
-
-	return sb.String()
+	_ = w.WriteByte(']')
 }
 
 // Add adds the given element at the last.
 func (a *RGA) Add(elem Element) {
-	a.insertAfter(a.last, elem)
+	a.insertAfter(a.last, newRGANode(elem))
+}
+
+// AddWithMovedAt adds the given element at the last with the given moved
+// time. It is used to restore an RGA.
+func (a *RGA) AddWithMovedAt(elem Element, movedAt *time.Ticket) {
+	node := newRGANode(elem)
+	if movedAt != nil {
+		node.positionedAt = movedAt
+	}
+	a.insertAfter(a.last, node)
+}
+
+// AddMovedAway adds the position that an element has moved away from at the
+// last. It is used to restore an RGA.
+func (a *RGA) AddMovedAway(positionedAt, movedAwayAt *time.Ticket) {
+	a.insertAfter(a.last, &RGANode{
+		positionedAt: positionedAt,
+		movedAwayAt:  movedAwayAt,
+	})
 }
 
-// Nodes returns an array of elements contained in this RGA.
+// Nodes returns the nodes of the elements contained in this RGA. The
+// positions that elements have moved away from are not included.
 // TODO If we encounter performance issues, we need to replace this with other solution.
 func (a *RGA) Nodes() []*RGANode {
 	var nodes []*RGANode
-	current := a.first.next
-	for {
-		if current == nil {
-			break
+	for current := a.first.next; current != nil; current = current.next {
+		if current.elem != nil {
+			nodes = append(nodes, current)
 		}
+	}
+
+	return nodes
+}
+
+// AllNodes returns all nodes of this RGA including the positions that
+// elements have moved away from.
+func (a *RGA) AllNodes() []*RGANode {
+	var nodes []*RGANode
+	for current := a.first.next; current != nil; current = current.next {
 		nodes = append(nodes, current)
-		current = current.next
 	}
 
 	return nodes
 }
 
-// LastCreatedAt returns the creation time of last elements.
+// LastCreatedAt returns the creation time of the last element which is not
+// deleted. Deleted elements are not used as the previous element of a new one,
+// because they can be purged by garbage collection.
 func (a *RGA) LastCreatedAt() *time.Ticket {
-	return a.last.elem.CreatedAt()
+	node := a.last
+	for node != a.first && node.isDeleted() {
+		node = node.prev
+	}
+
+	return node.elem.CreatedAt()
 }
 
-// InsertAfter inserts the given element after the given previous element.
-func (a *RGA) InsertAfter(prevCreatedAt *time.Ticket, elem Element) {
-	prevNode := a.findByCreatedAt(prevCreatedAt, elem.CreatedAt())
-	a.insertAfter(prevNode, elem)
+// PositionedAt returns the time when the element of the given creation time
+// was placed at its current position. Operations refer to this time instead
+// of the creation time, so that they are applied to the same position on
+// every replica even if the element is moved concurrently.
+func (a *RGA) PositionedAt(createdAt *time.Ticket) *time.Ticket {
+	node, ok := a.nodeMapByCreatedAt[createdAt.Key()]
+	if !ok {
+		return createdAt
+	}
+
+	return node.positionedAt
 }
 
-// Get returns the element of the given index.
+// InsertAfter inserts the given element after the given previous position.
+func (a *RGA) InsertAfter(prevPositionedAt *time.Ticket, elem Element) error {
+	prevNode, err := a.findByPositionedAt(prevPositionedAt, elem.CreatedAt())
+	if err != nil {
+		return err
+	}
+
+	a.insertAfter(prevNode, newRGANode(elem))
+	return nil
+}
+
+// MoveAfter moves the element of the given creation time to a new position
+// after the given previous position. The new position is ordered by the
+// given executed time like an inserted element, so it does not depend on
+// where the previous element is now. Among concurrent moves of the same
+// element, the last one wins, and the other positions are kept as moved-away
+// nodes. It returns ErrElementNotFound if the element does not exist.
+func (a *RGA) MoveAfter(prevPositionedAt, createdAt, executedAt *time.Ticket) error {
+	node, ok := a.nodeMapByCreatedAt[createdAt.Key()]
+	if !ok {
+		err := fmt.Errorf("%w: %s", ErrElementNotFound, createdAt.Key())
+		log.Logger.Warn(err)
+		return err
+	}
+
+	prevNode, err := a.findByPositionedAt(prevPositionedAt, executedAt)
+	if err != nil {
+		return err
+	}
+
+	newNode := &RGANode{positionedAt: executedAt}
+	if executedAt.After(node.positionedAt) {
+		newNode.elem = node.elem
+		a.moveAway(node, executedAt)
+	} else {
+		newNode.movedAwayAt = node.positionedAt
+	}
+	a.insertAfter(prevNode, newNode)
+
+	return nil
+}
+
+// PrevCreatedAt returns the creation time of the last element which is not
+// deleted before the element of the given creation time. The element of the
+// given except creation time is skipped if it is not nil. It returns
+// ErrElementNotFound if the element does not exist.
+func (a *RGA) PrevCreatedAt(createdAt, exceptCreatedAt *time.Ticket) (*time.Ticket, error) {
+	node, ok := a.nodeMapByCreatedAt[createdAt.Key()]
+	if !ok {
+		err := fmt.Errorf("%w: %s", ErrElementNotFound, createdAt.Key())
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	node = node.prev
+	for node != a.first && (node.isDeleted() ||
+		(exceptCreatedAt != nil && node.elem.CreatedAt().Compare(exceptCreatedAt) == 0)) {
+		node = node.prev
+	}
+
+	return node.elem.CreatedAt(), nil
+}
+
+// FirstCreatedAt returns the creation time of the dummy head.
+func (a *RGA) FirstCreatedAt() *time.Ticket {
+	return a.first.elem.CreatedAt()
+}
+
+// Get returns the element of the given index. Deleted elements are not
+// counted.
 func (a *RGA) Get(idx int) Element {
 	// TODO introduce LLRBTree for improving upstream performance
 	current := a.first.next
@@ -145,12 +310,14 @@ func (a *RGA) Get(idx int) Element {
 		if current == nil {
 			break
 		}
-		if idx == 0 {
-			return current.elem
+		if !current.isDeleted() {
+			if idx == 0 {
+				return current.elem
+			}
+			idx--
 		}
 
 		current = current.next
-		idx--
 	}
 	return nil
 }
@@ -158,8 +325,10 @@ func (a *RGA) Get(idx int) Element {
 // RemoveByCreatedAt removes the given element.
 func (a *RGA) RemoveByCreatedAt(createdAt *time.Ticket, deletedAt *time.Ticket) Element {
 	if node, ok := a.nodeMapByCreatedAt[createdAt.Key()]; ok {
+		if !node.isDeleted() {
+			a.size--
+		}
 		node.elem.Delete(deletedAt)
-		a.size--
 		return node.elem
 	}
 
@@ -172,29 +341,103 @@ func (a *RGA) Len() int {
 	return a.size
 }
 
-func (a *RGA) findByCreatedAt(prevCreatedAt *time.Ticket, createdAt *time.Ticket) *RGANode {
-	node := a.nodeMapByCreatedAt[prevCreatedAt.Key()]
-	if node == nil {
-		log.Logger.Fatalf(
-			"fail to find the given prevCreatedAt: %s",
-			prevCreatedAt.Key(),
-		)
-		return nil
+// purge physically purges the node of the given element.
+func (a *RGA) purge(elem Element) {
+	node, ok := a.nodeMapByCreatedAt[elem.CreatedAt().Key()]
+	if !ok {
+		log.Logger.Warnf("fail to find the given createdAt: %s", elem.CreatedAt().Key())
+		return
+	}
+	delete(a.nodeMapByCreatedAt, elem.CreatedAt().Key())
+	delete(a.nodeMapByPositionedAt, node.positionedAt.Key())
+	a.release(node)
+
+	if !node.isDeleted() {
+		a.size--
+	}
+}
+
+// garbageCollect physically purges the positions that elements moved away
+// from before the given time.
+func (a *RGA) garbageCollect(ticket *time.Ticket) int {
+	count := 0
+	for key, node := range a.movedAwayNodeMapByPositionedAt {
+		if ticket.Compare(node.movedAwayAt) >= 0 {
+			delete(a.movedAwayNodeMapByPositionedAt, key)
+			delete(a.nodeMapByPositionedAt, key)
+			a.release(node)
+			count++
+		}
+	}
+
+	return count
+}
+
+// garbageLen returns the number of the positions that elements moved away
+// from.
+func (a *RGA) garbageLen() int {
+	return len(a.movedAwayNodeMapByPositionedAt)
+}
+
+// moveAway marks that the element of the given node has moved away from it.
+func (a *RGA) moveAway(node *RGANode, movedAwayAt *time.Ticket) {
+	if !node.isDeleted() {
+		a.size--
 	}
 
-	for node.next != nil && createdAt.After(node.next.elem.CreatedAt()) {
+	node.elem = nil
+	node.movedAwayAt = movedAwayAt
+	a.movedAwayNodeMapByPositionedAt[node.positionedAt.Key()] = node
+}
+
+// release unlinks the given node from the list.
+func (a *RGA) release(node *RGANode) {
+	if node == a.last {
+		a.last = node.prev
+	}
+
+	node.prev.next = node.next
+	if node.next != nil {
+		node.next.prev = node.prev
+	}
+	node.prev, node.next = nil, nil
+}
+
+// findByPositionedAt returns the node after which a new position created at
+// the given time should be placed. Newer positions after the previous one
+// are skipped, as RGA orders concurrent inserts.
+func (a *RGA) findByPositionedAt(
+	prevPositionedAt *time.Ticket,
+	positionedAt *time.Ticket,
+) (*RGANode, error) {
+	node, ok := a.nodeMapByPositionedAt[prevPositionedAt.Key()]
+	if !ok {
+		err := fmt.Errorf("%w: %s", ErrPositionNotFound, prevPositionedAt.Key())
+		log.Logger.Error(err)
+		return nil, err
+	}
+
+	for node.next != nil && node.next.positionedAt.After(positionedAt) {
 		node = node.next
 	}
 
-	return node
+	return node, nil
 }
 
-func (a *RGA) insertAfter(prev *RGANode, element Element) {
-	newNode := newNodeAfter(prev, element)
+func (a *RGA) insertAfter(prev *RGANode, newNode *RGANode) {
+	linkNodeAfter(prev, newNode)
 	if prev == a.last {
 		a.last = newNode
 	}
 
-	a.size++
-	a.nodeMapByCreatedAt[element.CreatedAt().Key()] = newNode
+	a.nodeMapByPositionedAt[newNode.positionedAt.Key()] = newNode
+	if newNode.elem == nil {
+		a.movedAwayNodeMapByPositionedAt[newNode.positionedAt.Key()] = newNode
+		return
+	}
+
+	if !newNode.isDeleted() {
+		a.size++
+	}
+	a.nodeMapByCreatedAt[newNode.elem.CreatedAt().Key()] = newNode
 }
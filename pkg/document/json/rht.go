@@ -17,6 +17,8 @@
 package json
 
 import (
+	"sort"
+
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/log"
 	"github.com/yorkie-team/yorkie/pkg/pq"
@@ -34,8 +36,27 @@ func newRHTNode(key string, elem Element) *RHTNode {
 	}
 }
 
-func (n *RHTNode) Delete(deletedAt *time.Ticket) {
+// Key returns the key of this node.
+func (n *RHTNode) Key() string {
+	return n.key
+}
+
+// Element returns the element of this node.
+func (n *RHTNode) Element() Element {
+	return n.elem
+}
+
+// Delete marks the element of this node as deleted. If the element has been
+// already deleted, the earliest deletion is kept so that replicas agree on it
+// regardless of the order of operations. It returns whether the deletion time
+// has been changed.
+func (n *RHTNode) Delete(deletedAt *time.Ticket) bool {
+	if n.isDeleted() && !n.elem.DeletedAt().After(deletedAt) {
+		return false
+	}
+
 	n.elem.Delete(deletedAt)
+	return true
 }
 
 func (n *RHTNode) Less(other pq.Value) bool {
@@ -51,6 +72,7 @@ func (n *RHTNode) isDeleted() bool {
 type RHT struct {
 	nodeQueueMapByKey  map[string]*pq.PriorityQueue
 	nodeMapByCreatedAt map[string]*RHTNode
+	latestNodeMapByKey map[string]*RHTNode
 }
 
 // NewRHT creates a new instance of RHT.
@@ -58,34 +80,169 @@ func NewRHT() *RHT {
 	return &RHT{
 		nodeQueueMapByKey:  make(map[string]*pq.PriorityQueue),
 		nodeMapByCreatedAt: make(map[string]*RHTNode),
+		latestNodeMapByKey: make(map[string]*RHTNode),
 	}
 }
 
-// Get returns the value of the given key.
+// Get returns the value of the given key. If there are concurrent values of
+// the key, the newest one is returned.
 func (rht *RHT) Get(key string) Element {
-	if queue, ok := rht.nodeQueueMapByKey[key]; ok {
-		node := queue.Peek().(*RHTNode)
-		if node.isDeleted() {
-			return nil
-		}
+	if node, ok := rht.latestNodeMapByKey[key]; ok {
 		return node.elem
 	}
 
 	return nil
 }
 
+// updateLatest updates the newest live node of the given key. It scans only
+// the values of the key and is called when one of them is deleted or purged.
+func (rht *RHT) updateLatest(key string) {
+	delete(rht.latestNodeMapByKey, key)
+
+	queue, ok := rht.nodeQueueMapByKey[key]
+	if !ok {
+		return
+	}
+
+	for _, value := range queue.Values() {
+		node := value.(*RHTNode)
+		if node.isDeleted() {
+			continue
+		}
+		latest, ok := rht.latestNodeMapByKey[key]
+		if !ok || node.elem.CreatedAt().After(latest.elem.CreatedAt()) {
+			rht.latestNodeMapByKey[key] = node
+		}
+	}
+}
+
+// delete marks the given node as deleted and keeps the newest live node of
+// its key up to date.
+func (rht *RHT) delete(node *RHTNode, deletedAt *time.Ticket) bool {
+	if !node.Delete(deletedAt) {
+		return false
+	}
+
+	if rht.latestNodeMapByKey[node.key] == node {
+		rht.updateLatest(node.key)
+	}
+	return true
+}
+
+// keyOf returns the key of the element of the given creation time.
+func (rht *RHT) keyOf(createdAt *time.Ticket) (string, bool) {
+	node, ok := rht.nodeMapByCreatedAt[createdAt.Key()]
+	if !ok {
+		return "", false
+	}
+
+	return node.key, true
+}
+
 // Has returns whether the element exists of the given key or not.
 func (rht *RHT) Has(key string) bool {
-	if queue, ok := rht.nodeQueueMapByKey[key]; ok {
-		node := queue.Peek().(*RHTNode)
-		return node != nil && !node.isDeleted()
+	_, ok := rht.latestNodeMapByKey[key]
+	return ok
+}
+
+// Set sets the value of the given key. The element that loses to the other
+// by the creation time is marked as deleted at the creation time of the
+// winner so that it can be collected as garbage later. It returns the elements
+// marked as deleted.
+func (rht *RHT) Set(k string, v Element) []Element {
+	return rht.SetWithObserved(k, v, nil)
+}
+
+// SetWithObserved sets the value of the given key by a write that has observed
+// the values of the given creation times. Only the observed values are marked
+// as deleted, and the other live values are kept as concurrent values of the
+// key. If observed is nil, the write is regarded as having observed every
+// value and the last writer wins. It returns the elements marked as deleted.
+func (rht *RHT) SetWithObserved(k string, v Element, observed []*time.Ticket) []Element {
+	queue, ok := rht.nodeQueueMapByKey[k]
+	if !ok {
+		rht.set(k, v)
+		return nil
+	}
+
+	if observed == nil {
+		removed := rht.overwrite(queue, v)
+		rht.set(k, v)
+		return removed
+	}
+
+	var removed []Element
+	for _, createdAt := range observed {
+		if node, ok := rht.nodeMapByCreatedAt[createdAt.Key()]; ok && node.key == k {
+			if rht.delete(node, v.CreatedAt()) {
+				removed = append(removed, node.elem)
+			}
+		}
 	}
 
-	return false
+	rht.set(k, v)
+	return removed
 }
 
-// Set sets the value of the given key.
-func (rht *RHT) Set(k string, v Element) {
+// overwrite marks the live values in the given queue older than the given
+// element as deleted. If there is a newer live value, the given element is
+// marked as deleted instead.
+func (rht *RHT) overwrite(queue *pq.PriorityQueue, v Element) []Element {
+	var removed []Element
+	var newest *RHTNode
+	for _, value := range queue.Values() {
+		node := value.(*RHTNode)
+		if node.isDeleted() {
+			continue
+		}
+
+		if v.CreatedAt().After(node.elem.CreatedAt()) {
+			if rht.delete(node, v.CreatedAt()) {
+				removed = append(removed, node.elem)
+			}
+		} else if newest == nil || node.elem.CreatedAt().After(newest.elem.CreatedAt()) {
+			newest = node
+		}
+	}
+
+	if newest != nil && v.DeletedAt() == nil {
+		v.Delete(newest.elem.CreatedAt())
+		removed = append(removed, v)
+	}
+
+	return removed
+}
+
+// SetInternal sets the given node of the given key without resolving
+// conflicts. It is used to restore the nodes of a snapshot.
+func (rht *RHT) SetInternal(k string, v Element) {
+	rht.set(k, v)
+}
+
+// Conflicts returns the live values of the given key in descending order of
+// the creation time. The values other than the first one have been written
+// concurrently with it. If the first value has been removed, the remaining
+// concurrent values are still returned.
+func (rht *RHT) Conflicts(k string) []Element {
+	queue, ok := rht.nodeQueueMapByKey[k]
+	if !ok {
+		return nil
+	}
+
+	var elems []Element
+	for _, value := range queue.Values() {
+		if node := value.(*RHTNode); !node.isDeleted() {
+			elems = append(elems, node.elem)
+		}
+	}
+	sort.Slice(elems, func(i, j int) bool {
+		return elems[i].CreatedAt().After(elems[j].CreatedAt())
+	})
+
+	return elems
+}
+
+func (rht *RHT) set(k string, v Element) {
 	if _, ok := rht.nodeQueueMapByKey[k]; !ok {
 		rht.nodeQueueMapByKey[k] = pq.NewPriorityQueue()
 	}
@@ -93,22 +250,30 @@ func (rht *RHT) Set(k string, v Element) {
 	node := newRHTNode(k, v)
 	rht.nodeQueueMapByKey[k].Push(node)
 	rht.nodeMapByCreatedAt[v.CreatedAt().Key()] = node
+
+	if node.isDeleted() {
+		return
+	}
+	if latest, ok := rht.latestNodeMapByKey[k]; !ok || v.CreatedAt().After(latest.elem.CreatedAt()) {
+		rht.latestNodeMapByKey[k] = node
+	}
 }
 
-// Remove removes the Element of the given key.
-func (rht *RHT) Remove(k string, deletedAt *time.Ticket) Element {
-	if queue, ok := rht.nodeQueueMapByKey[k]; ok {
-		node := queue.Peek().(*RHTNode)
-		node.Delete(deletedAt)
-		return node.elem
+// Remove removes the live values of the given key including concurrent ones.
+// It returns the removed elements, newest first.
+func (rht *RHT) Remove(k string, deletedAt *time.Ticket) []Element {
+	removed := rht.Conflicts(k)
+	for _, elem := range removed {
+		rht.delete(rht.nodeMapByCreatedAt[elem.CreatedAt().Key()], deletedAt)
 	}
-	return nil
+
+	return removed
 }
 
 // RemoveByCreatedAt removes the Element of the given creation time.
 func (rht *RHT) RemoveByCreatedAt(createdAt *time.Ticket, deletedAt *time.Ticket) Element {
 	if node, ok := rht.nodeMapByCreatedAt[createdAt.Key()]; ok {
-		node.Delete(deletedAt)
+		rht.delete(node, deletedAt)
 		return node.elem
 	}
 
@@ -117,14 +282,10 @@ func (rht *RHT) RemoveByCreatedAt(createdAt *time.Ticket, deletedAt *time.Ticket
 }
 
 // Elements returns a map of elements because the map easy to use for loop.
-// TODO If we encounter performance issues, we need to replace this with other solution.
 func (rht *RHT) Elements() map[string]Element {
 	members := make(map[string]Element)
-	for _, queue := range rht.nodeQueueMapByKey {
-		if node := queue.Peek().(*RHTNode); !node.isDeleted() {
-			members[node.key] = node.elem
-
-		}
+	for key, node := range rht.latestNodeMapByKey {
+		members[key] = node.elem
 	}
 
 	return members
@@ -143,3 +304,23 @@ func (rht *RHT) AllNodes() []*RHTNode {
 
 	return nodes
 }
+
+// purge physically purges the node of the given element.
+func (rht *RHT) purge(elem Element) {
+	node, ok := rht.nodeMapByCreatedAt[elem.CreatedAt().Key()]
+	if !ok {
+		log.Logger.Warn("fail to find " + elem.CreatedAt().Key())
+		return
+	}
+	delete(rht.nodeMapByCreatedAt, elem.CreatedAt().Key())
+
+	queue := rht.nodeQueueMapByKey[node.key]
+	queue.Release(node)
+	if queue.Len() == 0 {
+		delete(rht.nodeQueueMapByKey, node.key)
+	}
+
+	if rht.latestNodeMapByKey[node.key] == node {
+		rht.updateLatest(node.key)
+	}
+}
@@ -20,6 +20,12 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
+// ElementPair represents pair of CRDT element and its parent.
+type ElementPair struct {
+	parent Container
+	elem   Element
+}
+
 // Root is a structure represents the root of JSON. It has a hash table of
 // all JSON elements to find a specific element when appling remote changes
 // received from agent.
@@ -27,28 +33,38 @@ import (
 // Every element has a unique time ticket at creation, which allows us to find
 // a particular element.
 type Root struct {
-	object                *Object
-	elementMapByCreatedAt map[string]Element
+	object                           *Object
+	elementMapByCreatedAt            map[string]Element
+	parentMapByCreatedAt             map[string]Container
+	removedElementPairMapByCreatedAt map[string]ElementPair
+	textWithGarbageMapByCreatedAt    map[string]*Text
+	arrayWithGarbageMapByCreatedAt   map[string]*Array
 }
 
 // NewRoot creates a new instance of Root.
 func NewRoot(root *Object) *Root {
-	elementMap := make(map[string]Element)
 	r := &Root{
-		object:                root,
-		elementMapByCreatedAt: elementMap,
+		object:                           root,
+		elementMapByCreatedAt:            make(map[string]Element),
+		parentMapByCreatedAt:             make(map[string]Container),
+		removedElementPairMapByCreatedAt: make(map[string]ElementPair),
+		textWithGarbageMapByCreatedAt:    make(map[string]*Text),
+		arrayWithGarbageMapByCreatedAt:   make(map[string]*Array),
 	}
 
-	r.RegisterElement(root)
+	r.RegisterElement(nil, root)
 
-	descendants := make(chan Element)
-	go func() {
-		root.Descendants(descendants)
-		close(descendants)
-	}()
-	for descendant := range descendants {
-		r.RegisterElement(descendant)
-	}
+	root.Descendants(func(elem Element, parent Container) {
+		if elem.DeletedAt() != nil {
+			r.RegisterRemovedElementPair(parent, elem)
+		}
+		if text, ok := elem.(*Text); ok && text.GarbageLen() > 0 {
+			r.RegisterTextWithGarbage(text)
+		}
+		if array, ok := elem.(*Array); ok && array.GarbageLen() > 0 {
+			r.RegisterArrayWithGarbage(array)
+		}
+	})
 
 	return r
 }
@@ -63,11 +79,161 @@ func (r *Root) FindByCreatedAt(createdAt *time.Ticket) Element {
 	return r.elementMapByCreatedAt[createdAt.Key()]
 }
 
-// RegisterElement registers the given element to hash table.
-func (r *Root) RegisterElement(elem Element) {
+// FindPath returns the path of the element of the given creation time from
+// the root, e.g. $.k1[0]. Removed elements do not have a path. The path is
+// resolved by following the parents of the element.
+func (r *Root) FindPath(createdAt *time.Ticket) (string, bool) {
+	var tokens []PathToken
+	for createdAt.Compare(r.object.CreatedAt()) != 0 {
+		elem := r.FindByCreatedAt(createdAt)
+		parent, ok := r.parentMapByCreatedAt[createdAt.Key()]
+		if elem == nil || !ok || elem.DeletedAt() != nil {
+			return "", false
+		}
+
+		token, ok := tokenOf(parent, createdAt)
+		if !ok {
+			return "", false
+		}
+
+		tokens = append(tokens, token)
+		createdAt = parent.CreatedAt()
+	}
+
+	path := "$"
+	for i := len(tokens) - 1; i >= 0; i-- {
+		path += tokens[i].String()
+	}
+
+	return path, true
+}
+
+// FindByPath returns the element of the given path from the root.
+func (r *Root) FindByPath(path string) (Element, error) {
+	return FindByPath(r.object, path)
+}
+
+// RegisterElement registers the given element of the given parent and its
+// descendants to hash table.
+func (r *Root) RegisterElement(parent Container, elem Element) {
 	r.elementMapByCreatedAt[elem.CreatedAt().Key()] = elem
+	if parent != nil {
+		r.parentMapByCreatedAt[elem.CreatedAt().Key()] = parent
+	}
+
+	if container, ok := elem.(Container); ok {
+		container.Descendants(func(elem Element, parent Container) {
+			r.elementMapByCreatedAt[elem.CreatedAt().Key()] = elem
+			r.parentMapByCreatedAt[elem.CreatedAt().Key()] = parent
+		})
+	}
+}
+
+// DeregisterElement deregister the given element from hash tables.
+func (r *Root) DeregisterElement(elem Element) {
+	delete(r.elementMapByCreatedAt, elem.CreatedAt().Key())
+	delete(r.parentMapByCreatedAt, elem.CreatedAt().Key())
+	delete(r.removedElementPairMapByCreatedAt, elem.CreatedAt().Key())
+	delete(r.textWithGarbageMapByCreatedAt, elem.CreatedAt().Key())
+	delete(r.arrayWithGarbageMapByCreatedAt, elem.CreatedAt().Key())
+}
+
+// RegisterRemovedElementPair register the given element pair to hash table.
+func (r *Root) RegisterRemovedElementPair(parent Container, elem Element) {
+	r.removedElementPairMapByCreatedAt[elem.CreatedAt().Key()] = ElementPair{
+		parent,
+		elem,
+	}
+}
+
+// RegisterTextWithGarbage register the given text that has removed nodes to
+// hash table.
+func (r *Root) RegisterTextWithGarbage(text *Text) {
+	r.textWithGarbageMapByCreatedAt[text.CreatedAt().Key()] = text
+}
+
+// RegisterArrayWithGarbage register the given array that has positions
+// elements moved away from to hash table.
+func (r *Root) RegisterArrayWithGarbage(array *Array) {
+	r.arrayWithGarbageMapByCreatedAt[array.CreatedAt().Key()] = array
 }
 
+// Deepcopy copies itself deeply.
 func (r *Root) Deepcopy() *Root {
 	return NewRoot(r.object.Deepcopy().(*Object))
 }
+
+// GarbageCollect purge elements that were removed before the given time.
+func (r *Root) GarbageCollect(ticket *time.Ticket) int {
+	count := 0
+
+	for _, pair := range r.removedElementPairMapByCreatedAt {
+		if pair.elem.DeletedAt() != nil && ticket.Compare(pair.elem.DeletedAt()) >= 0 {
+			pair.parent.Purge(pair.elem)
+			count += r.garbageCollect(pair.elem)
+		}
+	}
+
+	for key, text := range r.textWithGarbageMapByCreatedAt {
+		count += text.GarbageCollect(ticket)
+		if text.GarbageLen() == 0 {
+			delete(r.textWithGarbageMapByCreatedAt, key)
+		}
+	}
+
+	for key, array := range r.arrayWithGarbageMapByCreatedAt {
+		count += array.GarbageCollect(ticket)
+		if array.GarbageLen() == 0 {
+			delete(r.arrayWithGarbageMapByCreatedAt, key)
+		}
+	}
+
+	return count
+}
+
+// GarbageLen returns the count of removed elements.
+func (r *Root) GarbageLen() int {
+	seen := make(map[string]bool)
+
+	for _, pair := range r.removedElementPairMapByCreatedAt {
+		seen[pair.elem.CreatedAt().Key()] = true
+
+		switch elem := pair.elem.(type) {
+		case Container:
+			elem.Descendants(func(elem Element, parent Container) {
+				seen[elem.CreatedAt().Key()] = true
+			})
+		}
+	}
+
+	count := len(seen)
+	for key, text := range r.textWithGarbageMapByCreatedAt {
+		if !seen[key] {
+			count += text.GarbageLen()
+		}
+	}
+	for key, array := range r.arrayWithGarbageMapByCreatedAt {
+		if !seen[key] {
+			count += array.GarbageLen()
+		}
+	}
+
+	return count
+}
+
+func (r *Root) garbageCollect(elem Element) int {
+	count := 0
+
+	callback := func(elem Element, parent Container) {
+		r.DeregisterElement(elem)
+		count++
+	}
+
+	callback(elem, nil)
+	switch elem := elem.(type) {
+	case Container:
+		elem.Descendants(callback)
+	}
+
+	return count
+}
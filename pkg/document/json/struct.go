@@ -0,0 +1,80 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"reflect"
+	"strings"
+)
+
+// StructTag is the key of the struct tag used to bind struct fields to the
+// members of an object, e.g. `yorkie:"title,text"`.
+const StructTag = "yorkie"
+
+// StructField is a field of a struct bound to a member of an object.
+type StructField struct {
+	// Index is the index of the field in the struct.
+	Index int
+
+	// Key is the key of the member. It is the name of the field unless the
+	// tag has a name.
+	Key string
+
+	// IsText is whether the string field is bound to Text or not. It is set
+	// by the text option of the tag.
+	IsText bool
+
+	// OmitEmpty is whether the member is removed if the field is empty. It
+	// is set by the omitempty option of the tag.
+	OmitEmpty bool
+}
+
+// StructFields returns the fields of the given struct type bound to members.
+// Unexported fields and the fields tagged with "-" are skipped.
+func StructFields(t reflect.Type) []StructField {
+	var fields []StructField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		tag := field.Tag.Get(StructTag)
+		if tag == "-" {
+			continue
+		}
+
+		options := strings.Split(tag, ",")
+		structField := StructField{Index: i, Key: options[0]}
+		if structField.Key == "" {
+			structField.Key = field.Name
+		}
+
+		for _, option := range options[1:] {
+			switch option {
+			case "text":
+				structField.IsText = field.Type.Kind() == reflect.String
+			case "omitempty":
+				structField.OmitEmpty = true
+			}
+		}
+
+		fields = append(fields, structField)
+	}
+
+	return fields
+}
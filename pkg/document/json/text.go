@@ -17,7 +17,9 @@
 package json
 
 import (
+	"bufio"
 	"fmt"
+	"sort"
 	"strings"
 	"unicode/utf8"
 
@@ -81,6 +83,10 @@ func (t *TextNodeID) AnnotatedString() string {
 	return fmt.Sprintf("%s:%d", t.createdAt.AnnotatedString(), t.offset)
 }
 
+func (t *TextNodeID) key() string {
+	return fmt.Sprintf("%s:%d", t.createdAt.Key(), t.offset)
+}
+
 func (t *TextNodeID) hasSameCreatedAt(id *TextNodeID) bool {
 	return t.createdAt.Compare(id.createdAt) == 0
 }
@@ -112,10 +118,28 @@ func (pos *TextNodePos) RelativeOffset() int {
 	return pos.relativeOffset
 }
 
+// TextAttr is an attribute of a text node such as bold, link and heading.
+// Concurrent updates on the same attribute are resolved by the update time.
+type TextAttr struct {
+	value     string
+	updatedAt *time.Ticket
+}
+
+// Value returns the value of this attribute.
+func (a *TextAttr) Value() string {
+	return a.value
+}
+
+// UpdatedAt returns the update time of this attribute.
+func (a *TextAttr) UpdatedAt() *time.Ticket {
+	return a.updatedAt
+}
+
 type TextNode struct {
 	id        *TextNodeID
 	indexNode *splay.Node
 	value     string
+	attrs     map[string]*TextAttr
 	deletedAt *time.Ticket
 
 	prev    *TextNode
@@ -124,10 +148,12 @@ type TextNode struct {
 	insNext *TextNode
 }
 
-func newTextNode(id *TextNodeID, value string) *TextNode {
+// NewTextNode creates a new instance of TextNode.
+func NewTextNode(id *TextNodeID, value string) *TextNode {
 	node := &TextNode{
 		id:    id,
 		value: value,
+		attrs: make(map[string]*TextAttr),
 	}
 	node.indexNode = splay.NewNode(node)
 
@@ -161,11 +187,70 @@ func (t *TextNode) String() string {
 	return t.value
 }
 
+// DeletedAt returns the deletion time of this node.
+func (t *TextNode) DeletedAt() *time.Ticket {
+	return t.deletedAt
+}
+
+// Delete marks this node as deleted at the given time. It is used to restore
+// a node, use delete for editing.
+func (t *TextNode) Delete(deletedAt *time.Ticket) {
+	t.deletedAt = deletedAt
+}
+
+// Attrs returns the attributes of this node.
+func (t *TextNode) Attrs() map[string]*TextAttr {
+	return t.attrs
+}
+
+// SetAttr sets the given attribute if the given time is after the time of
+// the existing one.
+func (t *TextNode) SetAttr(key, value string, updatedAt *time.Ticket) {
+	if attr, ok := t.attrs[key]; ok && !updatedAt.After(attr.updatedAt) {
+		return
+	}
+
+	t.attrs[key] = &TextAttr{
+		value:     value,
+		updatedAt: updatedAt,
+	}
+}
+
+// marshalAttrs returns the JSON encoding of the attributes sorted by key. It
+// returns an empty string if there are no attributes.
+func (t *TextNode) marshalAttrs() string {
+	if len(t.attrs) == 0 {
+		return ""
+	}
+
+	var keys []string
+	for key := range t.attrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var members []string
+	for _, key := range keys {
+		members = append(members, quote(key)+":"+quote(t.attrs[key].value))
+	}
+
+	return fmt.Sprintf("{%s}", strings.Join(members, ","))
+}
+
+func (t *TextNode) copyAttrs() map[string]*TextAttr {
+	attrs := make(map[string]*TextAttr)
+	for key, attr := range t.attrs {
+		attrs[key] = attr
+	}
+	return attrs
+}
+
 // DeepCopy returns a new instance of this TextNode without structural info.
 func (t *TextNode) DeepCopy() *TextNode {
 	node := &TextNode{
 		id:        t.id,
 		value:     t.value,
+		attrs:     t.copyAttrs(),
 		deletedAt: t.deletedAt,
 	}
 	node.indexNode = splay.NewNode(node)
@@ -184,10 +269,13 @@ func (t *TextNode) setPrev(node *TextNode) {
 }
 
 func (t *TextNode) split(offset int) *TextNode {
-	return newTextNode(
+	node := NewTextNode(
 		t.id.split(offset),
 		t.splitContent(offset),
 	)
+	node.attrs = t.copyAttrs()
+	node.deletedAt = t.deletedAt
+	return node
 }
 
 func (t *TextNode) splitContent(offset int) string {
@@ -207,23 +295,65 @@ func (t *TextNode) annotatedString() string {
 	return fmt.Sprintf("%s %s", t.id.AnnotatedString(), t.value)
 }
 
+// delete marks this node as deleted. If the node has been already deleted, the
+// earliest deletion is kept so that the node keeps the time of the edit that
+// actually removed it.
 func (t *TextNode) delete(editedAt *time.Ticket, latestCreatedAt *time.Ticket) bool {
 	if !t.createdAt().After(latestCreatedAt) &&
-		(t.deletedAt == nil || editedAt.After(t.deletedAt)) {
+		(t.deletedAt == nil || t.deletedAt.After(editedAt)) {
 		t.deletedAt = editedAt
 		return true
 	}
 	return false
 }
 
+// PurgedTextNode is the trace of a text node purged by garbage collection.
+// It keeps the position where the node was, so that positions in the node
+// such as anchors and selections can still be resolved.
+type PurgedTextNode struct {
+	id          *TextNodeID
+	fallbackPos *TextNodePos
+}
+
+// NewPurgedTextNode creates a new instance of PurgedTextNode.
+func NewPurgedTextNode(id *TextNodeID, fallbackPos *TextNodePos) *PurgedTextNode {
+	return &PurgedTextNode{
+		id:          id,
+		fallbackPos: fallbackPos,
+	}
+}
+
+// ID returns the ID of the purged node.
+func (p *PurgedTextNode) ID() *TextNodeID {
+	return p.id
+}
+
+// FallbackPos returns the position where the purged node was.
+func (p *PurgedTextNode) FallbackPos() *TextNodePos {
+	return p.fallbackPos
+}
+
+// String returns the string representation of this purged node.
+func (p *PurgedTextNode) String() string {
+	return p.id.AnnotatedString()
+}
+
 type RGATreeSplit struct {
 	initialHead *TextNode
 	treeByIndex *splay.Tree
 	treeByID    *llrb.Tree
+
+	// removedNodeMap is a map to store removed nodes. It is used to purge
+	// removed nodes physically when they are no longer needed.
+	removedNodeMap map[string]*TextNode
+
+	// purgedTreeByID is a tree of the traces of purged nodes. It is used to
+	// resolve positions in the nodes that have been purged.
+	purgedTreeByID *llrb.Tree
 }
 
 func NewRGATreeSplit() *RGATreeSplit {
-	initialHead := newTextNode(initialTextNodeID, "")
+	initialHead := NewTextNode(initialTextNodeID, "")
 	treeByIndex := splay.NewTree()
 	treeByID := llrb.NewTree()
 
@@ -231,9 +361,11 @@ func NewRGATreeSplit() *RGATreeSplit {
 	treeByID.Put(initialHead.ID(), initialHead)
 
 	return &RGATreeSplit{
-		initialHead: initialHead,
-		treeByIndex: treeByIndex,
-		treeByID:    treeByID,
+		initialHead:    initialHead,
+		treeByIndex:    treeByIndex,
+		treeByID:       treeByID,
+		removedNodeMap: make(map[string]*TextNode),
+		purgedTreeByID: llrb.NewTree(),
 	}
 }
 
@@ -249,17 +381,126 @@ func (s *RGATreeSplit) createRange(from, to int) (*TextNodePos, *TextNodePos) {
 func (s *RGATreeSplit) findTextNodePos(index int) *TextNodePos {
 	splayNode, offset := s.treeByIndex.Find(index)
 	textNode := splayNode.Value().(*TextNode)
+
+	// NOTE: A position at the beginning of a node is anchored at the end of
+	// the previous live node, because removed nodes can be purged.
+	if offset == 0 {
+		for textNode != s.initialHead {
+			textNode = textNode.prev
+			if textNode.deletedAt == nil {
+				break
+			}
+		}
+		offset = textNode.contentLen()
+	}
+
 	return &TextNodePos{
 		id:             textNode.ID(),
 		relativeOffset: offset,
 	}
 }
 
+// findIndex returns the current index of the given position. A position with
+// left gravity is attached to the end of the previous split node of the same
+// insertion. If the node of the position has been purged, the index where the
+// node was is returned. It returns false if the position is unknown.
+func (s *RGATreeSplit) findIndex(pos *TextNodePos, gravity Gravity) (int, bool) {
+	id := pos.getAbsoluteID()
+	if fallbackPos := s.findPurgedPos(id); fallbackPos != nil {
+		return s.findIndexAfter(fallbackPos)
+	}
+
+	node := s.findFloorTextNode(id)
+	if node == nil {
+		return 0, false
+	}
+
+	if gravity == LeftGravity && (node.id.offset != id.offset || node.insPrev != nil) {
+		node = s.findFloorTextNodePreferToLeft(id)
+	}
+
+	index := s.treeByIndex.IndexOf(node.indexNode)
+	if node.deletedAt == nil {
+		offset := id.offset - node.id.offset
+		if offset > node.contentLen() {
+			offset = node.contentLen()
+		}
+		index += offset
+	}
+
+	return index, true
+}
+
+// findIndexAfter returns the index right after the character before the given
+// position. If the character has been purged, it follows where the character
+// was.
+func (s *RGATreeSplit) findIndexAfter(pos *TextNodePos) (int, bool) {
+	id := pos.getAbsoluteID()
+	if id.offset == 0 {
+		return s.findIndex(pos, LeftGravity)
+	}
+
+	charID := NewTextNodeID(id.createdAt, id.offset-1)
+	if fallbackPos := s.findPurgedPos(charID); fallbackPos != nil {
+		return s.findIndexAfter(fallbackPos)
+	}
+
+	node := s.findFloorTextNode(charID)
+	if node == nil {
+		return 0, false
+	}
+
+	index := s.treeByIndex.IndexOf(node.indexNode)
+	if node.deletedAt == nil {
+		index += charID.offset - node.id.offset + 1
+	}
+
+	return index, true
+}
+
+// findPurgedPos returns the position where the purged node containing the
+// given ID was. It returns nil if the ID is in a node that is not purged.
+func (s *RGATreeSplit) findPurgedPos(id *TextNodeID) *TextNodePos {
+	key, value := s.purgedTreeByID.Floor(id)
+	if key == nil || !key.(*TextNodeID).hasSameCreatedAt(id) {
+		return nil
+	}
+
+	if node := s.findFloorTextNode(id); node != nil && node.id.Compare(key) > 0 {
+		return nil
+	}
+
+	return value.(*PurgedTextNode).fallbackPos
+}
+
+// AddPurgedNode adds the trace of a purged node.
+func (s *RGATreeSplit) AddPurgedNode(node *PurgedTextNode) {
+	s.purgedTreeByID.Put(node.id, node)
+}
+
+func (s *RGATreeSplit) purgedNodes() []*PurgedTextNode {
+	var nodes []*PurgedTextNode
+	for _, value := range s.purgedTreeByID.Values() {
+		nodes = append(nodes, value.(*PurgedTextNode))
+	}
+	return nodes
+}
+
+// findTextNodeWithSplit splits the node at the given position and returns the
+// nodes on both sides of it. If the character before the position has been
+// purged, the position follows where the character was.
 func (s *RGATreeSplit) findTextNodeWithSplit(
 	pos *TextNodePos,
 	editedAt *time.Ticket,
 ) (*TextNode, *TextNode) {
 	absoluteID := pos.getAbsoluteID()
+	if absoluteID.offset > 0 {
+		charID := NewTextNodeID(absoluteID.createdAt, absoluteID.offset-1)
+		if fallbackPos := s.findPurgedPos(charID); fallbackPos != nil {
+			return s.findTextNodeWithSplit(fallbackPos, editedAt)
+		}
+	}
+
 	node := s.findFloorTextNodePreferToLeft(absoluteID)
 
 	relativeOffset := absoluteID.offset - node.id.offset
@@ -325,6 +566,9 @@ func (s *RGATreeSplit) InsertAfter(prev *TextNode, node *TextNode) *TextNode {
 
 	s.treeByID.Put(node.id, node)
 	s.treeByIndex.InsertAfter(prev.indexNode, node.indexNode)
+	if node.deletedAt != nil {
+		s.removedNodeMap[node.id.key()] = node
+	}
 
 	return node
 }
@@ -363,14 +607,14 @@ func (s *RGATreeSplit) edit(
 	latestCreatedAtMapByActor map[string]*time.Ticket,
 	content string,
 	editedAt *time.Ticket,
-) (*TextNodePos, map[string]*time.Ticket) {
+) (*TextNodePos, map[string]*time.Ticket, []*TextChange) {
 	// 01. split nodes with from and to
 	toLeft, toRight := s.findTextNodeWithSplit(to, editedAt)
 	fromLeft, fromRight := s.findTextNodeWithSplit(from, editedAt)
 
 	// 02. delete between from and to
 	nodesToDelete := s.findBetween(fromRight, toRight)
-	latestCreatedAtMap := s.deleteNodes(nodesToDelete, latestCreatedAtMapByActor, editedAt)
+	latestCreatedAtMap, changes := s.deleteNodes(nodesToDelete, latestCreatedAtMapByActor, editedAt)
 
 	var caretID *TextNodeID
 	if toRight == nil {
@@ -382,13 +626,71 @@ func (s *RGATreeSplit) edit(
 
 	// 03. insert a new node
 	if content != "" {
-		inserted := s.InsertAfter(fromLeft, newTextNode(NewTextNodeID(editedAt, 0), content))
+		inserted := s.InsertAfter(fromLeft, NewTextNode(NewTextNodeID(editedAt, 0), content))
 		caretPos = NewTextNodePos(inserted.id, inserted.contentLen())
+
+		index := s.treeByIndex.IndexOf(inserted.indexNode)
+		if len(changes) > 0 && changes[len(changes)-1].From == index {
+			changes[len(changes)-1].Content = content
+		} else {
+			changes = append(changes, newTextChange(editedAt.ActorID(), index, index, content))
+		}
+	}
+
+	return caretPos, latestCreatedAtMap, changes
+}
+
+func (s *RGATreeSplit) style(
+	from *TextNodePos,
+	to *TextNodePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	attrs map[string]string,
+	editedAt *time.Ticket,
+) map[string]*time.Ticket {
+	// 01. split nodes with from and to
+	_, toRight := s.findTextNodeWithSplit(to, editedAt)
+	_, fromRight := s.findTextNodeWithSplit(from, editedAt)
+
+	// 02. style nodes between from and to
+	createdAtMapByActor := make(map[string]*time.Ticket)
+	for _, node := range s.findBetween(fromRight, toRight) {
+		actorIDHex := node.createdAt().ActorIDHex()
+
+		var latestCreatedAt *time.Ticket
+		if latestCreatedAtMapByActor == nil {
+			latestCreatedAt = time.MaxTicket
+		} else {
+			createdAt, ok := latestCreatedAtMapByActor[actorIDHex]
+			if ok {
+				latestCreatedAt = createdAt
+			} else {
+				latestCreatedAt = time.InitialTicket
+			}
+		}
+
+		// NOTE: Nodes inserted concurrently are not styled to converge.
+		if node.createdAt().After(latestCreatedAt) {
+			continue
+		}
+
+		for key, value := range attrs {
+			node.SetAttr(key, value, editedAt)
+		}
+
+		latestCreatedAt = createdAtMapByActor[actorIDHex]
+		createdAt := node.id.createdAt
+		if latestCreatedAt == nil || createdAt.After(latestCreatedAt) {
+			createdAtMapByActor[actorIDHex] = createdAt
+		}
 	}
 
-	return caretPos, latestCreatedAtMap
+	return createdAtMapByActor
 }
 
+// findBetween returns the nodes from the given from node to the node before
+// the given to node. It returns nothing if the to node is before the from
+// node, which happens when the end of a remote range has been purged and
+// follows where the purged characters were.
 func (s *RGATreeSplit) findBetween(from *TextNode, to *TextNode) []*TextNode {
 	current := from
 	var nodes []*TextNode
@@ -396,15 +698,21 @@ func (s *RGATreeSplit) findBetween(from *TextNode, to *TextNode) []*TextNode {
 		nodes = append(nodes, current)
 		current = current.next
 	}
+	if current != to {
+		return nil
+	}
 	return nodes
 }
 
+// deleteNodes deletes the given nodes and returns the latest creation times
+// of the deleted nodes by actor and the changes in indexes.
 func (s *RGATreeSplit) deleteNodes(
 	candidates []*TextNode,
 	latestCreatedAtMapByActor map[string]*time.Ticket,
 	editedAt *time.Ticket,
-) map[string]*time.Ticket {
+) (map[string]*time.Ticket, []*TextChange) {
 	createdAtMapByActor := make(map[string]*time.Ticket)
+	var changes []*TextChange
 
 	for _, node := range candidates {
 		actorIDHex := node.createdAt().ActorIDHex()
@@ -421,8 +729,14 @@ func (s *RGATreeSplit) deleteNodes(
 			}
 		}
 
+		wasLive := node.deletedAt == nil
 		if node.delete(editedAt, latestCreatedAt) {
 			s.treeByIndex.Splay(node.indexNode)
+			s.removedNodeMap[node.id.key()] = node
+
+			if wasLive {
+				changes = appendDeletion(changes, editedAt.ActorID(), s.treeByIndex.IndexOf(node.indexNode), node.contentLen())
+			}
 
 			latestCreatedAt := createdAtMapByActor[actorIDHex]
 			createdAt := node.id.createdAt
@@ -432,7 +746,45 @@ func (s *RGATreeSplit) deleteNodes(
 		}
 	}
 
-	return createdAtMapByActor
+	return createdAtMapByActor, changes
+}
+
+// purgeTextNodesWithGarbage physically purges nodes that have been removed
+// before the given time.
+func (s *RGATreeSplit) purgeTextNodesWithGarbage(ticket *time.Ticket) int {
+	count := 0
+	for key, node := range s.removedNodeMap {
+		if node.deletedAt != nil && ticket.Compare(node.deletedAt) >= 0 {
+			s.treeByIndex.Delete(node.indexNode)
+			s.AddPurgedNode(NewPurgedTextNode(
+				node.id,
+				NewTextNodePos(node.prev.id, node.prev.contentLen()),
+			))
+			s.purge(node)
+			s.treeByID.Remove(node.id)
+			delete(s.removedNodeMap, key)
+			count++
+		}
+	}
+
+	return count
+}
+
+// purge unlinks the given node from the list and the insertion history.
+func (s *RGATreeSplit) purge(node *TextNode) {
+	node.prev.next = node.next
+	if node.next != nil {
+		node.next.prev = node.prev
+	}
+	node.prev, node.next = nil, nil
+
+	if node.insPrev != nil {
+		node.insPrev.insNext = node.insNext
+	}
+	if node.insNext != nil {
+		node.insNext.insPrev = node.insPrev
+	}
+	node.insPrev, node.insNext = nil, nil
 }
 
 func (s *RGATreeSplit) marshal() string {
@@ -449,6 +801,43 @@ func (s *RGATreeSplit) marshal() string {
 	return strings.Join(values, "")
 }
 
+// marshalWithAttrs returns the JSON encoding of the live nodes including their
+// attributes. Adjacent nodes with the same attributes are merged.
+func (s *RGATreeSplit) marshalWithAttrs() string {
+	var values []string
+	var attrs string
+	var content strings.Builder
+
+	flush := func() {
+		if content.Len() == 0 {
+			return
+		}
+
+		if attrs == "" {
+			values = append(values, fmt.Sprintf(`{"content":%s}`, quote(content.String())))
+		} else {
+			values = append(values, fmt.Sprintf(`{"attrs":%s,"content":%s}`, attrs, quote(content.String())))
+		}
+		content.Reset()
+	}
+
+	node := s.initialHead.next
+	for node != nil {
+		if node.deletedAt == nil {
+			nodeAttrs := node.marshalAttrs()
+			if nodeAttrs != attrs {
+				flush()
+				attrs = nodeAttrs
+			}
+			content.WriteString(node.value)
+		}
+		node = node.next
+	}
+	flush()
+
+	return fmt.Sprintf("[%s]", strings.Join(values, ","))
+}
+
 func (s *RGATreeSplit) textNodes() []*TextNode {
 	var nodes []*TextNode
 
@@ -490,6 +879,47 @@ func (s *RGATreeSplit) AnnotatedString() string {
 	return strings.Join(result, "")
 }
 
+// TextChange is a change of a text in indexes. The indexes of a change are
+// based on the text after applying the previous changes of the same edit.
+type TextChange struct {
+	// Actor is the actor who made the change.
+	Actor *time.ActorID
+
+	// From and To are the range of the text replaced by the content.
+	From int
+	To   int
+
+	// Content is the inserted content.
+	Content string
+}
+
+func newTextChange(actor *time.ActorID, from, to int, content string) *TextChange {
+	return &TextChange{
+		Actor:   actor,
+		From:    from,
+		To:      to,
+		Content: content,
+	}
+}
+
+// appendDeletion appends the deletion of the given range to the changes. It
+// is merged into the last change if they are adjacent.
+func appendDeletion(changes []*TextChange, actor *time.ActorID, index, length int) []*TextChange {
+	if len(changes) > 0 && changes[len(changes)-1].From == index {
+		changes[len(changes)-1].To += length
+		return changes
+	}
+
+	return append(changes, newTextChange(actor, index, index+length, ""))
+}
+
+// TextRange is a range of a text in indexes.
+type TextRange struct {
+	From int
+	To   int
+}
+
+// Selection is the range of a text selected by an actor.
 type Selection struct {
 	from      *TextNodePos
 	to        *TextNodePos
@@ -504,6 +934,21 @@ func newSelection(from, to *TextNodePos, updatedAt *time.Ticket) *Selection {
 	}
 }
 
+// From returns the start position of this selection.
+func (s *Selection) From() *TextNodePos {
+	return s.from
+}
+
+// To returns the end position of this selection.
+func (s *Selection) To() *TextNodePos {
+	return s.to
+}
+
+// UpdatedAt returns the update time of this selection.
+func (s *Selection) UpdatedAt() *time.Ticket {
+	return s.updatedAt
+}
+
 // Text is an extended data type for the contents of a text editor.
 type Text struct {
 	rgaTreeSplit *RGATreeSplit
@@ -521,8 +966,30 @@ func NewText(elements *RGATreeSplit, createdAt *time.Ticket) *Text {
 	}
 }
 
+// Marshal returns the JSON encoding of this Text.
 func (t *Text) Marshal() string {
-	return fmt.Sprintf("\"%s\"", t.rgaTreeSplit.marshal())
+	return marshal(t)
+}
+
+// MarshalJSON returns the JSON encoding of this Text.
+func (t *Text) MarshalJSON() ([]byte, error) {
+	return []byte(t.Marshal()), nil
+}
+
+func (t *Text) writeJSON(w *bufio.Writer) {
+	writeString(w, t.rgaTreeSplit.marshal())
+}
+
+// String returns the content of this Text without quotes.
+func (t *Text) String() string {
+	return t.rgaTreeSplit.marshal()
+}
+
+// MarshalWithAttrs returns the JSON encoding of this Text including the
+// attributes of each run of characters, e.g.
+// [{"attrs":{"bold":"true"},"content":"Hello"},{"content":" world"}].
+func (t *Text) MarshalWithAttrs() string {
+	return t.rgaTreeSplit.marshalWithAttrs()
 }
 
 func (t *Text) Deepcopy() Element {
@@ -541,7 +1008,16 @@ func (t *Text) Deepcopy() Element {
 		}
 	}
 
-	return NewText(rgaTreeSplit, t.createdAt)
+	for _, purgedNode := range t.PurgedNodes() {
+		rgaTreeSplit.AddPurgedNode(purgedNode)
+	}
+
+	text := NewText(rgaTreeSplit, t.createdAt)
+	for actor, selection := range t.selectionMap {
+		text.selectionMap[actor] = selection
+	}
+	text.deletedAt = t.deletedAt
+	return text
 }
 
 // CreatedAt returns the creation time of this Text.
@@ -564,14 +1040,17 @@ func (t *Text) CreateRange(from, to int) (*TextNodePos, *TextNodePos) {
 	return t.rgaTreeSplit.createRange(from, to)
 }
 
+// Edit replaces the given range with the given content. It returns the
+// position of the caret, the latest creation times of the deleted nodes by
+// actor and the changes in indexes.
 func (t *Text) Edit(
 	from,
 	to *TextNodePos,
 	latestCreatedAtMapByActor map[string]*time.Ticket,
 	content string,
 	editedAt *time.Ticket,
-) (*TextNodePos, map[string]*time.Ticket) {
-	cursorPos, latestCreatedAtMapByActor := t.rgaTreeSplit.edit(
+) (*TextNodePos, map[string]*time.Ticket, []*TextChange) {
+	cursorPos, latestCreatedAtMapByActor, changes := t.rgaTreeSplit.edit(
 		from,
 		to,
 		latestCreatedAtMapByActor,
@@ -583,9 +1062,34 @@ func (t *Text) Edit(
 		editedAt.ActorID().String(),
 		t.rgaTreeSplit.AnnotatedString(),
 	)
-	return cursorPos, latestCreatedAtMapByActor
+	return cursorPos, latestCreatedAtMapByActor, changes
 }
 
+// Style applies the given attributes to the given range.
+func (t *Text) Style(
+	from,
+	to *TextNodePos,
+	latestCreatedAtMapByActor map[string]*time.Ticket,
+	attrs map[string]string,
+	editedAt *time.Ticket,
+) map[string]*time.Ticket {
+	latestCreatedAtMap := t.rgaTreeSplit.style(
+		from,
+		to,
+		latestCreatedAtMapByActor,
+		attrs,
+		editedAt,
+	)
+	log.Logger.Debugf(
+		"STYL: '%s' styles %s",
+		editedAt.ActorID().String(),
+		t.rgaTreeSplit.AnnotatedString(),
+	)
+	return latestCreatedAtMap
+}
+
+// Select updates the selection of the actor of the given time ticket if the
+// ticket is after the previous one.
 func (t *Text) Select(
 	from *TextNodePos,
 	to *TextNodePos,
@@ -608,10 +1112,55 @@ func (t *Text) Select(
 	}
 }
 
+// GarbageCollect purges the text nodes that have been removed before the
+// given time. It returns the number of purged nodes.
+func (t *Text) GarbageCollect(ticket *time.Ticket) int {
+	return t.rgaTreeSplit.purgeTextNodesWithGarbage(ticket)
+}
+
+// GarbageLen returns the number of removed text nodes.
+func (t *Text) GarbageLen() int {
+	return len(t.rgaTreeSplit.removedNodeMap)
+}
+
+// SelectionMap returns the selections of this Text by actor.
+func (t *Text) SelectionMap() map[string]*Selection {
+	return t.selectionMap
+}
+
+// Selections returns the selections of this Text by actor with the positions
+// converted to the current indexes. The selections whose positions can not
+// be found are skipped.
+func (t *Text) Selections() map[string]*TextRange {
+	selections := make(map[string]*TextRange)
+	for actor, selection := range t.selectionMap {
+		from, ok := t.rgaTreeSplit.findIndex(selection.from, LeftGravity)
+		if !ok {
+			continue
+		}
+		to, ok := t.rgaTreeSplit.findIndex(selection.to, LeftGravity)
+		if !ok {
+			continue
+		}
+
+		if from > to {
+			from, to = to, from
+		}
+		selections[actor] = &TextRange{From: from, To: to}
+	}
+
+	return selections
+}
+
 func (t *Text) TextNodes() []*TextNode {
 	return t.rgaTreeSplit.textNodes()
 }
 
+// PurgedNodes returns the traces of the nodes purged from this Text.
+func (t *Text) PurgedNodes() []*PurgedTextNode {
+	return t.rgaTreeSplit.purgedNodes()
+}
+
 // AnnotatedString returns a string containing the meta data of the text
 // for debugging purpose.
 func (t *Text) AnnotatedString() string {
@@ -50,14 +50,16 @@ func (o *Add) Execute(root *json.Root) error {
 
 	obj, ok := parent.(*json.Array)
 	if !ok {
-		err := fmt.Errorf("fail to execute, only Array can execute Set")
+		err := fmt.Errorf("fail to execute, only Array can execute Add")
 		log.Logger.Error(err)
 		return err
 	}
 
 	value := o.value.Deepcopy()
-	obj.InsertAfter(o.prevCreatedAt, value)
-	root.RegisterElement(value)
+	if err := obj.InsertAfter(o.prevCreatedAt, value); err != nil {
+		return err
+	}
+	root.RegisterElement(obj, value)
 	return nil
 }
 
@@ -77,6 +79,9 @@ func (o *Add) SetActor(actorID *time.ActorID) {
 	o.executedAt = o.executedAt.SetActorID(actorID)
 }
 
+// PrevCreatedAt returns the position of the previous element. It is the
+// creation time of the previous element, or the time when it was moved to
+// its position.
 func (o *Add) PrevCreatedAt() *time.Ticket {
 	return o.prevCreatedAt
 }
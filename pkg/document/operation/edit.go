@@ -52,16 +52,26 @@ func NewEdit(
 }
 
 func (e *Edit) Execute(root *json.Root) error {
+	_, err := e.ExecuteWithChanges(root)
+	return err
+}
+
+// ExecuteWithChanges executes this operation on the given root and returns
+// the changes of the text in indexes.
+func (e *Edit) ExecuteWithChanges(root *json.Root) ([]*json.TextChange, error) {
 	parent := root.FindByCreatedAt(e.parentCreatedAt)
 	obj, ok := parent.(*json.Text)
 	if !ok {
 		err := fmt.Errorf("fail to execute, only Text can execute Edit")
 		log.Logger.Error(err)
-		return err
+		return nil, err
 	}
 
-	obj.Edit(e.from, e.to, e.latestCreatedAtMapByActor, e.content, e.executedAt)
-	return nil
+	_, _, changes := obj.Edit(e.from, e.to, e.latestCreatedAtMapByActor, e.content, e.executedAt)
+	if obj.GarbageLen() > 0 {
+		root.RegisterTextWithGarbage(obj)
+	}
+	return changes, nil
 }
 
 func (e *Edit) From() *json.TextNodePos {
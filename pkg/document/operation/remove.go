@@ -27,35 +27,52 @@ import (
 type Remove struct {
 	parentCreatedAt *time.Ticket
 	createdAt       *time.Ticket
-	executedAt      *time.Ticket
+
+	// observedCreatedAts is the creation times of the values of the same key
+	// that the actor has seen when removing the key. They are removed
+	// together with the element of createdAt.
+	observedCreatedAts []*time.Ticket
+	executedAt         *time.Ticket
 }
 
 func NewRemove(
 	parentCreatedAt *time.Ticket,
 	createdAt *time.Ticket,
+	observedCreatedAts []*time.Ticket,
 	executedAt *time.Ticket,
 ) *Remove {
 	return &Remove{
-		parentCreatedAt: parentCreatedAt,
-		createdAt:       createdAt,
-		executedAt:      executedAt,
+		parentCreatedAt:    parentCreatedAt,
+		createdAt:          createdAt,
+		observedCreatedAts: observedCreatedAts,
+		executedAt:         executedAt,
 	}
 }
 
 func (o *Remove) Execute(root *json.Root) error {
 	parent := root.FindByCreatedAt(o.parentCreatedAt)
 
+	var removed []json.Element
 	switch obj := parent.(type) {
 	case *json.Object:
-		_ = obj.RemoveByCreatedAt(o.createdAt, o.executedAt)
+		removed = append(removed, obj.RemoveByCreatedAt(o.createdAt, o.executedAt))
+		for _, createdAt := range o.observedCreatedAts {
+			removed = append(removed, obj.RemoveByCreatedAt(createdAt, o.executedAt))
+		}
 	case *json.Array:
-		_ = obj.RemoveByCreatedAt(o.createdAt, o.executedAt)
+		removed = append(removed, obj.RemoveByCreatedAt(o.createdAt, o.executedAt))
 	default:
 		err := fmt.Errorf("fail to execute, only Object, Array can execute Remove")
 		log.Logger.Error(err)
 		return err
 	}
 
+	for _, elem := range removed {
+		if elem != nil {
+			root.RegisterRemovedElementPair(parent.(json.Container), elem)
+		}
+	}
+
 	return nil
 }
 
@@ -74,3 +91,9 @@ func (o *Remove) SetActor(actorID *time.ActorID) {
 func (o *Remove) CreatedAt() *time.Ticket {
 	return o.createdAt
 }
+
+// ObservedCreatedAts returns the creation times of the values of the same key
+// that the actor has seen when removing the key.
+func (o *Remove) ObservedCreatedAts() []*time.Ticket {
+	return o.observedCreatedAts
+}
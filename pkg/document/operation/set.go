@@ -28,20 +28,27 @@ type Set struct {
 	parentCreatedAt *time.Ticket
 	key             string
 	value           json.Element
-	executedAt      *time.Ticket
+
+	// observedCreatedAts is the creation times of the values of the key that
+	// the actor has seen when setting the value. The other values are
+	// concurrent with this value. If it is nil, the last writer wins.
+	observedCreatedAts []*time.Ticket
+	executedAt         *time.Ticket
 }
 
 func NewSet(
 	parentCreatedAt *time.Ticket,
 	key string,
 	value json.Element,
+	observedCreatedAts []*time.Ticket,
 	executedAt *time.Ticket,
 ) *Set {
 	return &Set{
-		key:             key,
-		value:           value,
-		parentCreatedAt: parentCreatedAt,
-		executedAt:      executedAt,
+		key:                key,
+		value:              value,
+		parentCreatedAt:    parentCreatedAt,
+		observedCreatedAts: observedCreatedAts,
+		executedAt:         executedAt,
 	}
 }
 
@@ -56,8 +63,11 @@ func (o *Set) Execute(root *json.Root) error {
 	}
 
 	value := o.value.Deepcopy()
-	obj.Set(o.key, value)
-	root.RegisterElement(value)
+	removed := obj.SetWithObserved(o.key, value, o.observedCreatedAts)
+	root.RegisterElement(obj, value)
+	for _, elem := range removed {
+		root.RegisterRemovedElementPair(obj, elem)
+	}
 	return nil
 }
 
@@ -80,3 +90,9 @@ func (o *Set) Key() string {
 func (o *Set) Value() json.Element {
 	return o.value
 }
+
+// ObservedCreatedAts returns the creation times of the values of the key that
+// the actor has seen when setting the value.
+func (o *Set) ObservedCreatedAts() []*time.Ticket {
+	return o.observedCreatedAts
+}
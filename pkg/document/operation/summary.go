@@ -0,0 +1,104 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Summarize returns a human-readable summary of the given operation, e.g.
+// `set "title" = "Hello" in 0:0:00`. Elements are referred to by their
+// creation time because the operation does not know the paths of them.
+func Summarize(op Operation) string {
+	switch op := op.(type) {
+	case *Set:
+		return fmt.Sprintf(
+			"set %q = %s in %s",
+			op.Key(),
+			op.Value().Marshal(),
+			op.ParentCreatedAt().AnnotatedString(),
+		)
+	case *Add:
+		return fmt.Sprintf(
+			"add %s after %s in %s",
+			op.Value().Marshal(),
+			op.PrevCreatedAt().AnnotatedString(),
+			op.ParentCreatedAt().AnnotatedString(),
+		)
+	case *Move:
+		return fmt.Sprintf(
+			"move %s after %s in %s",
+			op.CreatedAt().AnnotatedString(),
+			op.PrevCreatedAt().AnnotatedString(),
+			op.ParentCreatedAt().AnnotatedString(),
+		)
+	case *Remove:
+		return fmt.Sprintf(
+			"remove %s in %s",
+			op.CreatedAt().AnnotatedString(),
+			op.ParentCreatedAt().AnnotatedString(),
+		)
+	case *Edit:
+		return fmt.Sprintf(
+			"edit %s~%s with %q in %s",
+			op.From().AnnotatedString(),
+			op.To().AnnotatedString(),
+			op.Content(),
+			op.ParentCreatedAt().AnnotatedString(),
+		)
+	case *Style:
+		return fmt.Sprintf(
+			"style %s~%s with %s in %s",
+			op.From().AnnotatedString(),
+			op.To().AnnotatedString(),
+			summarizeAttrs(op.Attributes()),
+			op.ParentCreatedAt().AnnotatedString(),
+		)
+	case *Select:
+		return fmt.Sprintf(
+			"select %s~%s in %s",
+			op.From().AnnotatedString(),
+			op.To().AnnotatedString(),
+			op.ParentCreatedAt().AnnotatedString(),
+		)
+	case *Increase:
+		return fmt.Sprintf(
+			"increase %s by %s",
+			op.ParentCreatedAt().AnnotatedString(),
+			op.Value().Marshal(),
+		)
+	}
+
+	return fmt.Sprintf("unknown operation %T", op)
+}
+
+func summarizeAttrs(attrs map[string]string) string {
+	var keys []string
+	for key := range attrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", key, attrs[key]))
+	}
+
+	return "{" + strings.Join(pairs, ",") + "}"
+}
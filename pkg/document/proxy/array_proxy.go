@@ -94,14 +94,114 @@ func (p *ArrayProxy) AddString(v string) *ArrayProxy {
 	return p
 }
 
+func (p *ArrayProxy) AddNewObject() *ObjectProxy {
+	v, err := p.addInternal(func(ticket *time.Ticket) json.Element {
+		return NewObjectProxy(p.context, json.NewObject(json.NewRHT(), ticket))
+	})
+	if err != nil {
+		return nil
+	}
+
+	return v.(*ObjectProxy)
+}
+
+func (p *ArrayProxy) AddNewText() *TextProxy {
+	v, err := p.addInternal(func(ticket *time.Ticket) json.Element {
+		return NewTextProxy(p.context, json.NewText(json.NewRGATreeSplit(), ticket))
+	})
+	if err != nil {
+		return nil
+	}
+
+	return v.(*TextProxy)
+}
+
 func (p *ArrayProxy) AddNewArray() *ArrayProxy {
-	v := p.addInternal(func(ticket *time.Ticket) json.Element {
+	v, err := p.addInternal(func(ticket *time.Ticket) json.Element {
 		return NewArrayProxy(p.context, json.NewArray(json.NewRGA(), ticket))
 	})
+	if err != nil {
+		return nil
+	}
 
 	return v.(*ArrayProxy)
 }
 
+func (p *ArrayProxy) AddNewCounter(n interface{}) *CounterProxy {
+	v, err := p.addInternal(func(ticket *time.Ticket) json.Element {
+		return NewCounterProxy(p.context, json.NewCounter(n, ticket))
+	})
+	if err != nil {
+		return nil
+	}
+
+	return v.(*CounterProxy)
+}
+
+func (p *ArrayProxy) GetObject(idx int) *ObjectProxy {
+	elem := p.Array.Get(idx)
+	if elem == nil {
+		return nil
+	}
+
+	switch elem := elem.(type) {
+	case *json.Object:
+		return NewObjectProxy(p.context, elem)
+	case *ObjectProxy:
+		return elem
+	default:
+		panic("unsupported type")
+	}
+}
+
+func (p *ArrayProxy) GetArray(idx int) *ArrayProxy {
+	elem := p.Array.Get(idx)
+	if elem == nil {
+		return nil
+	}
+
+	switch elem := elem.(type) {
+	case *json.Array:
+		return NewArrayProxy(p.context, elem)
+	case *ArrayProxy:
+		return elem
+	default:
+		panic("unsupported type")
+	}
+}
+
+func (p *ArrayProxy) GetText(idx int) *TextProxy {
+	elem := p.Array.Get(idx)
+	if elem == nil {
+		return nil
+	}
+
+	switch elem := elem.(type) {
+	case *json.Text:
+		return NewTextProxy(p.context, elem)
+	case *TextProxy:
+		return elem
+	default:
+		panic("unsupported type")
+	}
+}
+
+func (p *ArrayProxy) GetCounter(idx int) *CounterProxy {
+	elem := p.Array.Get(idx)
+	if elem == nil {
+		return nil
+	}
+
+	switch elem := elem.(type) {
+	case *json.Counter:
+		return NewCounterProxy(p.context, elem)
+	case *CounterProxy:
+		return elem
+	default:
+		panic("unsupported type")
+	}
+}
+
 func (p *ArrayProxy) Remove(idx int) json.Element {
 	if p.Len() <= idx {
 		log.Logger.Warnf("the given index is out of bound: %d", idx)
@@ -113,30 +213,239 @@ func (p *ArrayProxy) Remove(idx int) json.Element {
 	p.context.Push(operation.NewRemove(
 		p.CreatedAt(),
 		removed.CreatedAt(),
+		nil,
 		ticket,
 	))
+	p.context.RegisterRemovedElementPair(p.Array, removed)
+
+	return removed
+}
+
+// InsertValueAfter inserts the given value after the element of the given
+// prevCreatedAt. Maps with string keys, structs and slices are inserted as
+// objects and arrays, and other values as primitives.
+func (p *ArrayProxy) InsertValueAfter(prevCreatedAt *time.Ticket, v interface{}) *ArrayProxy {
+	val, err := toValue(v)
+	if err != nil {
+		log.Logger.Error(err)
+		return p
+	}
+
+	p.insertValueAfter(prevCreatedAt, val)
+	return p
+}
+
+// InsertValueBefore inserts the given value before the element of the given
+// nextCreatedAt.
+func (p *ArrayProxy) InsertValueBefore(nextCreatedAt *time.Ticket, v interface{}) *ArrayProxy {
+	prevCreatedAt, err := p.Array.PrevCreatedAt(nextCreatedAt, nil)
+	if err != nil {
+		return p
+	}
+
+	return p.InsertValueAfter(prevCreatedAt, v)
+}
+
+// InsertAt inserts the given value at the given index.
+func (p *ArrayProxy) InsertAt(idx int, v interface{}) *ArrayProxy {
+	if idx < 0 || p.Len() < idx {
+		log.Logger.Warnf("the given index is out of bound: %d", idx)
+		return p
+	}
+
+	return p.InsertValueAfter(p.prevCreatedAtOf(idx), v)
+}
+
+// InsertNewObjectAt inserts a new object at the given index.
+func (p *ArrayProxy) InsertNewObjectAt(idx int) *ObjectProxy {
+	v := p.insertNewAt(idx, func(ticket *time.Ticket) json.Element {
+		return NewObjectProxy(p.context, json.NewObject(json.NewRHT(), ticket))
+	})
+	if v == nil {
+		return nil
+	}
+
+	return v.(*ObjectProxy)
+}
+
+// InsertNewArrayAt inserts a new array at the given index.
+func (p *ArrayProxy) InsertNewArrayAt(idx int) *ArrayProxy {
+	v := p.insertNewAt(idx, func(ticket *time.Ticket) json.Element {
+		return NewArrayProxy(p.context, json.NewArray(json.NewRGA(), ticket))
+	})
+	if v == nil {
+		return nil
+	}
+
+	return v.(*ArrayProxy)
+}
+
+// InsertNewTextAt inserts a new text at the given index.
+func (p *ArrayProxy) InsertNewTextAt(idx int) *TextProxy {
+	v := p.insertNewAt(idx, func(ticket *time.Ticket) json.Element {
+		return NewTextProxy(p.context, json.NewText(json.NewRGATreeSplit(), ticket))
+	})
+	if v == nil {
+		return nil
+	}
+
+	return v.(*TextProxy)
+}
+
+// InsertNewCounterAt inserts a new counter of the given value at the given
+// index.
+func (p *ArrayProxy) InsertNewCounterAt(idx int, n interface{}) *CounterProxy {
+	v := p.insertNewAt(idx, func(ticket *time.Ticket) json.Element {
+		return NewCounterProxy(p.context, json.NewCounter(n, ticket))
+	})
+	if v == nil {
+		return nil
+	}
+
+	return v.(*CounterProxy)
+}
+
+// Splice removes the given number of elements from the given start index and
+// inserts the given values in their place. Values are converted as in
+// InsertValueAfter. It returns the removed elements.
+func (p *ArrayProxy) Splice(start, deleteCount int, values ...interface{}) []json.Element {
+	if start < 0 || p.Len() < start {
+		log.Logger.Warnf("the given index is out of bound: %d", start)
+		return nil
+	}
+
+	vals := make([]*value, 0, len(values))
+	for _, v := range values {
+		val, err := toValue(v)
+		if err != nil {
+			log.Logger.Error(err)
+			return nil
+		}
+		vals = append(vals, val)
+	}
+
+	var removed []json.Element
+	for i := 0; i < deleteCount && start < p.Len(); i++ {
+		removed = append(removed, p.Remove(start))
+	}
+
+	prevCreatedAt := p.prevCreatedAtOf(start)
+	for _, val := range vals {
+		elem, err := p.insertValueAfter(prevCreatedAt, val)
+		if err != nil {
+			return removed
+		}
+		prevCreatedAt = elem.CreatedAt()
+	}
 
 	return removed
 }
 
+// MoveBefore moves the element of the given createdAt before the element of
+// the given nextCreatedAt.
+func (p *ArrayProxy) MoveBefore(nextCreatedAt, createdAt *time.Ticket) {
+	prevCreatedAt, err := p.Array.PrevCreatedAt(nextCreatedAt, createdAt)
+	if err != nil {
+		return
+	}
+
+	p.moveAfterInternal(prevCreatedAt, createdAt)
+}
+
+// MoveAfter moves the element of the given createdAt after the element of
+// the given prevCreatedAt.
+func (p *ArrayProxy) MoveAfter(prevCreatedAt, createdAt *time.Ticket) {
+	p.moveAfterInternal(prevCreatedAt, createdAt)
+}
+
+func (p *ArrayProxy) moveAfterInternal(prevCreatedAt, createdAt *time.Ticket) {
+	if prevCreatedAt.Compare(createdAt) == 0 {
+		return
+	}
+
+	ticket := p.context.IssueTimeTicket()
+	prevPositionedAt := p.Array.PositionedAt(prevCreatedAt)
+	if err := p.Array.MoveAfter(prevPositionedAt, createdAt, ticket); err != nil {
+		log.Logger.Error(err)
+		return
+	}
+
+	p.context.Push(operation.NewMove(
+		p.Array.CreatedAt(),
+		prevPositionedAt,
+		createdAt,
+		ticket,
+	))
+	if p.Array.GarbageLen() > 0 {
+		p.context.RegisterArrayWithGarbage(p.Array)
+	}
+}
+
 func (p *ArrayProxy) addInternal(
 	creator func(ticket *time.Ticket) json.Element,
-) json.Element {
+) (json.Element, error) {
+	return p.insertAfterInternal(p.Array.LastCreatedAt(), creator)
+}
+
+// insertAfterInternal inserts the element created by the given creator after
+// the element of the given prevCreatedAt. It returns an error without pushing
+// the operation if the element cannot be inserted.
+func (p *ArrayProxy) insertAfterInternal(
+	prevCreatedAt *time.Ticket,
+	creator func(ticket *time.Ticket) json.Element,
+) (json.Element, error) {
 	ticket := p.context.IssueTimeTicket()
 	proxy := creator(ticket)
 	value := toOriginal(proxy)
 
+	prevPositionedAt := p.Array.PositionedAt(prevCreatedAt)
+	if err := p.Array.InsertAfter(prevPositionedAt, value); err != nil {
+		log.Logger.Error(err)
+		return nil, err
+	}
+
 	p.context.Push(operation.NewAdd(
 		p.Array.CreatedAt(),
-		p.Array.LastCreatedAt(),
+		prevPositionedAt,
 		value.Deepcopy(),
 		ticket,
 	))
+	p.context.RegisterElement(p.Array, value)
 
-	p.Add(value)
-	p.context.RegisterElement(value)
+	return proxy, nil
+}
+
+func (p *ArrayProxy) insertValueAfter(prevCreatedAt *time.Ticket, val *value) (json.Element, error) {
+	return p.insertAfterInternal(prevCreatedAt, func(ticket *time.Ticket) json.Element {
+		return buildElement(p.context, val, ticket)
+	})
+}
+
+func (p *ArrayProxy) insertNewAt(
+	idx int,
+	creator func(ticket *time.Ticket) json.Element,
+) json.Element {
+	if idx < 0 || p.Len() < idx {
+		log.Logger.Warnf("the given index is out of bound: %d", idx)
+		return nil
+	}
+
+	elem, err := p.insertAfterInternal(p.prevCreatedAtOf(idx), creator)
+	if err != nil {
+		return nil
+	}
+
+	return elem
+}
+
+// prevCreatedAtOf returns the creation time of the element that a new element
+// at the given index should be inserted after.
+func (p *ArrayProxy) prevCreatedAtOf(idx int) *time.Ticket {
+	if idx == 0 {
+		return p.Array.FirstCreatedAt()
+	}
 
-	return proxy
+	return p.Array.Get(idx - 1).CreatedAt()
 }
 
 func (p *ArrayProxy) Len() int {
@@ -0,0 +1,57 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package proxy
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operation"
+)
+
+type CounterProxy struct {
+	*json.Counter
+	context *change.Context
+}
+
+func NewCounterProxy(ctx *change.Context, counter *json.Counter) *CounterProxy {
+	return &CounterProxy{
+		Counter: counter,
+		context: ctx,
+	}
+}
+
+// Increase adds an increase operation. Only numeric types(int, int64 and
+// float64) are allowed as the given value.
+func (p *CounterProxy) Increase(v interface{}) *CounterProxy {
+	switch v.(type) {
+	case int, int64, float64:
+	default:
+		panic("unsupported type")
+	}
+
+	ticket := p.context.IssueTimeTicket()
+	value := json.NewPrimitive(v, ticket)
+
+	p.context.Push(operation.NewIncrease(
+		p.CreatedAt(),
+		value,
+		ticket,
+	))
+
+	p.Counter.Increase(value)
+	return p
+}
@@ -0,0 +1,257 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package proxy
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// Encode makes this object match the given struct or map with as few
+// operations as possible. Struct fields are bound to members by the yorkie
+// tag, e.g. `yorkie:"title,text"` binds a string field to Text. Members that
+// are not in the given value are removed.
+func (p *ObjectProxy) Encode(v interface{}) error {
+	val, err := toValue(v)
+	if err != nil {
+		return err
+	}
+
+	if val.kind != objectValue {
+		return fmt.Errorf("%w: %T", ErrUnsupportedValue, v)
+	}
+
+	p.encodeObject(val)
+	return nil
+}
+
+func (p *ObjectProxy) encodeObject(v *value) {
+	var removedKeys []string
+	for key := range p.Object.Members() {
+		if _, ok := v.members[key]; !ok {
+			removedKeys = append(removedKeys, key)
+		}
+	}
+	sort.Strings(removedKeys)
+
+	for _, key := range removedKeys {
+		p.Remove(key)
+	}
+
+	for _, key := range v.keys {
+		member := v.members[key]
+		if encodeInPlace(p.context, p.Object.Get(key), member) {
+			continue
+		}
+
+		p.setInternal(key, func(ticket *time.Ticket) json.Element {
+			return buildElement(p.context, member, ticket)
+		})
+	}
+}
+
+// encodeArray keeps the longest common subsequence of the elements and the
+// given value, and encodes the others in place if possible, otherwise
+// replaces them.
+func (p *ArrayProxy) encodeArray(v *value) {
+	elements := p.Array.Elements()
+	matches := longestCommonSubsequence(elements, v.elements)
+	matches = append(matches, [2]int{len(elements), len(v.elements)})
+
+	prevCreatedAt := p.Array.FirstCreatedAt()
+	oldIdx, newIdx := 0, 0
+	for _, match := range matches {
+		for oldIdx < match[0] || newIdx < match[1] {
+			if oldIdx < match[0] && newIdx < match[1] &&
+				encodeInPlace(p.context, elements[oldIdx], v.elements[newIdx]) {
+				prevCreatedAt = elements[oldIdx].CreatedAt()
+				oldIdx++
+				newIdx++
+				continue
+			}
+
+			if oldIdx < match[0] {
+				p.removeByCreatedAt(elements[oldIdx].CreatedAt())
+				oldIdx++
+			}
+
+			if newIdx < match[1] {
+				element := v.elements[newIdx]
+				inserted, err := p.insertAfterInternal(prevCreatedAt, func(ticket *time.Ticket) json.Element {
+					return buildElement(p.context, element, ticket)
+				})
+				if err != nil {
+					return
+				}
+				prevCreatedAt = inserted.CreatedAt()
+				newIdx++
+			}
+		}
+
+		if match[0] < len(elements) {
+			prevCreatedAt = elements[match[0]].CreatedAt()
+		}
+		oldIdx, newIdx = match[0]+1, match[1]+1
+	}
+}
+
+// encodeText edits the text to match the given content. Only the range
+// between the common prefix and suffix is edited.
+func (p *TextProxy) encodeText(content string) {
+	current := []rune(p.Text.String())
+	target := []rune(content)
+
+	prefix := 0
+	for prefix < len(current) && prefix < len(target) && current[prefix] == target[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(current)-prefix && suffix < len(target)-prefix &&
+		current[len(current)-1-suffix] == target[len(target)-1-suffix] {
+		suffix++
+	}
+
+	if prefix == len(current) && prefix == len(target) {
+		return
+	}
+
+	p.Edit(prefix, len(current)-suffix, string(target[prefix:len(target)-suffix]))
+}
+
+// encodeCounter increases the counter to be the given value. It returns
+// false if the given value can not be the value of the counter.
+func (p *CounterProxy) encodeCounter(v interface{}) bool {
+	switch p.Counter.ValueType() {
+	case json.Double:
+		var target float64
+		switch v := v.(type) {
+		case int:
+			target = float64(v)
+		case int64:
+			target = float64(v)
+		case float64:
+			target = v
+		default:
+			return false
+		}
+
+		if delta := target - p.Counter.Value().(float64); delta != 0 {
+			p.Increase(delta)
+		}
+		return true
+	default:
+		var target int64
+		switch v := v.(type) {
+		case int:
+			target = int64(v)
+		case int64:
+			target = v
+		default:
+			return false
+		}
+
+		var current int64
+		switch value := p.Counter.Value().(type) {
+		case int:
+			current = int64(value)
+		case int64:
+			current = value
+		}
+
+		if delta := target - current; delta != 0 {
+			p.Increase(delta)
+		}
+		return true
+	}
+}
+
+// encodeInPlace makes the given element match the given value without
+// replacing the element. It returns false if the element should be replaced.
+func encodeInPlace(ctx *change.Context, elem json.Element, v *value) bool {
+	switch elem := elem.(type) {
+	case *json.Object:
+		if v.kind != objectValue {
+			return false
+		}
+		NewObjectProxy(ctx, elem).encodeObject(v)
+		return true
+	case *json.Array:
+		if v.kind != arrayValue {
+			return false
+		}
+		NewArrayProxy(ctx, elem).encodeArray(v)
+		return true
+	case *json.Text:
+		if v.kind != textValue {
+			return false
+		}
+		NewTextProxy(ctx, elem).encodeText(v.text)
+		return true
+	case *json.Counter:
+		if v.kind != primitiveValue {
+			return false
+		}
+		return NewCounterProxy(ctx, elem).encodeCounter(v.primitive)
+	case *json.Primitive:
+		return v.equals(elem)
+	}
+
+	return false
+}
+
+// longestCommonSubsequence returns the index pairs of the longest common
+// subsequence of the given elements and values. Only primitives and texts
+// can be equal.
+func longestCommonSubsequence(elements []json.Element, values []*value) [][2]int {
+	lengths := make([][]int, len(elements)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(values)+1)
+	}
+
+	for i := len(elements) - 1; i >= 0; i-- {
+		for j := len(values) - 1; j >= 0; j-- {
+			if values[j].equals(elements[i]) {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	var matches [][2]int
+	for i, j := 0, 0; i < len(elements) && j < len(values); {
+		switch {
+		case values[j].equals(elements[i]):
+			matches = append(matches, [2]int{i, j})
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return matches
+}
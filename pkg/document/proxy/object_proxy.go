@@ -17,6 +17,12 @@
 package proxy
 
 import (
+	"bytes"
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
 	time2 "time"
 
 	"github.com/yorkie-team/yorkie/pkg/document/change"
@@ -61,6 +67,14 @@ func (p *ObjectProxy) SetNewText(k string) *TextProxy {
 	return v.(*TextProxy)
 }
 
+func (p *ObjectProxy) SetNewCounter(k string, n interface{}) *CounterProxy {
+	v := p.setInternal(k, func(ticket *time.Ticket) json.Element {
+		return NewCounterProxy(p.context, json.NewCounter(n, ticket))
+	})
+
+	return v.(*CounterProxy)
+}
+
 func (p *ObjectProxy) SetBool(k string, v bool) *ObjectProxy {
 	p.setInternal(k, func(ticket *time.Ticket) json.Element {
 		return json.NewPrimitive(v, ticket)
@@ -124,12 +138,24 @@ func (p *ObjectProxy) Remove(k string) json.Element {
 
 	ticket := p.context.IssueTimeTicket()
 	removed := p.Object.Remove(k, ticket)
+
+	// NOTE: The concurrent values of the key are removed together because
+	// the user has seen them.
+	var observed []*time.Ticket
+	for _, elem := range removed[1:] {
+		observed = append(observed, elem.CreatedAt())
+	}
+
 	p.context.Push(operation.NewRemove(
 		p.CreatedAt(),
-		removed.CreatedAt(),
+		removed[0].CreatedAt(),
+		observed,
 		ticket,
 	))
-	return removed
+	for _, elem := range removed {
+		p.context.RegisterRemovedElementPair(p.Object, elem)
+	}
+	return removed[0]
 }
 
 func (p *ObjectProxy) GetObject(k string) *ObjectProxy {
@@ -180,6 +206,199 @@ func (p *ObjectProxy) GetText(k string) *TextProxy {
 	}
 }
 
+func (p *ObjectProxy) GetCounter(k string) *CounterProxy {
+	elem := p.Object.Get(k)
+	if elem == nil {
+		return nil
+	}
+
+	switch elem := p.Object.Get(k).(type) {
+	case *json.Counter:
+		return NewCounterProxy(p.context, elem)
+	case *CounterProxy:
+		return elem
+	default:
+		panic("unsupported type")
+	}
+}
+
+// SetValue sets the given value of the given key. Maps with string keys,
+// structs, slices and primitives are converted into objects, arrays and
+// primitives, and the whole value is set by a single operation.
+func (p *ObjectProxy) SetValue(k string, v interface{}) error {
+	val, err := toValue(v)
+	if err != nil {
+		return err
+	}
+
+	p.setInternal(k, func(ticket *time.Ticket) json.Element {
+		return buildElement(p.context, val, ticket)
+	})
+
+	return nil
+}
+
+// SetFromJSON sets the value of the given JSON encoding of the given key.
+// Numbers are stored as integers if they have no fraction and fit in, and as
+// doubles otherwise.
+func (p *ObjectProxy) SetFromJSON(k string, data []byte) error {
+	decoder := gojson.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return err
+	}
+
+	value, err := fromJSONValue(v)
+	if err != nil {
+		return err
+	}
+
+	return p.SetValue(k, value)
+}
+
+// Conflicts returns the live values of the given key including the ones
+// written concurrently, newest first. Objects, arrays, texts and counters are
+// returned as their proxies. Setting the key resolves the conflicts.
+func (p *ObjectProxy) Conflicts(k string) []json.Element {
+	var elems []json.Element
+	for _, elem := range p.Object.Conflicts(k) {
+		elems = append(elems, toProxy(p.context, elem))
+	}
+
+	return elems
+}
+
+// GetPath returns the element of the given path from this object, e.g.
+// $.todos[2].title. Objects, arrays, texts and counters are returned as their
+// proxies.
+func (p *ObjectProxy) GetPath(path string) (json.Element, error) {
+	elem, err := json.FindByPath(p.Object, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProxy(p.context, elem), nil
+}
+
+// GetObjectByPath returns the object of the given path.
+func (p *ObjectProxy) GetObjectByPath(path string) (*ObjectProxy, error) {
+	elem, err := p.GetPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, ok := elem.(*ObjectProxy)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", json.ErrUnexpectedType, path)
+	}
+	return obj, nil
+}
+
+// GetArrayByPath returns the array of the given path.
+func (p *ObjectProxy) GetArrayByPath(path string) (*ArrayProxy, error) {
+	elem, err := p.GetPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	arr, ok := elem.(*ArrayProxy)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", json.ErrUnexpectedType, path)
+	}
+	return arr, nil
+}
+
+// GetTextByPath returns the text of the given path.
+func (p *ObjectProxy) GetTextByPath(path string) (*TextProxy, error) {
+	elem, err := p.GetPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	text, ok := elem.(*TextProxy)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", json.ErrUnexpectedType, path)
+	}
+	return text, nil
+}
+
+// GetCounterByPath returns the counter of the given path.
+func (p *ObjectProxy) GetCounterByPath(path string) (*CounterProxy, error) {
+	elem, err := p.GetPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	counter, ok := elem.(*CounterProxy)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", json.ErrUnexpectedType, path)
+	}
+	return counter, nil
+}
+
+// SetPath sets the given primitive value at the given path, e.g.
+// $.settings.theme. The objects on the way are created if they do not exist.
+// If the path ends with an index of an array, the value is added when the
+// index is the length of the array, otherwise it replaces the element.
+func (p *ObjectProxy) SetPath(path string, v interface{}) error {
+	if !isPrimitiveValue(v) {
+		return fmt.Errorf("%w: %T", ErrUnsupportedValue, v)
+	}
+
+	tokens, err := json.ParsePath(path)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("%w: %s", json.ErrInvalidPath, path)
+	}
+
+	var parent json.Element = p.Object
+	for i, token := range tokens[:len(tokens)-1] {
+		child, err := json.FindByToken(parent, token)
+		if obj, ok := parent.(*json.Object); ok &&
+			errors.Is(err, json.ErrPathNotFound) && !tokens[i+1].IsIndex {
+			child = NewObjectProxy(p.context, obj).SetNewObject(token.Key).Object
+		} else if err != nil {
+			return fmt.Errorf("%w: %s", err, path)
+		}
+
+		parent = child
+	}
+
+	token := tokens[len(tokens)-1]
+	switch parent := parent.(type) {
+	case *json.Object:
+		if token.IsIndex {
+			return fmt.Errorf("%w: %s", json.ErrUnexpectedType, path)
+		}
+
+		NewObjectProxy(p.context, parent).setInternal(token.Key, func(ticket *time.Ticket) json.Element {
+			return json.NewPrimitive(v, ticket)
+		})
+	case *json.Array:
+		if !token.IsIndex {
+			return fmt.Errorf("%w: %s", json.ErrUnexpectedType, path)
+		}
+
+		arr := NewArrayProxy(p.context, parent)
+		switch {
+		case token.Index == arr.Len():
+			arr.InsertAt(token.Index, v)
+		case token.Index >= 0 && token.Index < arr.Len():
+			arr.Splice(token.Index, 1, v)
+		default:
+			return fmt.Errorf("%w: %s", json.ErrPathNotFound, path)
+		}
+	default:
+		return fmt.Errorf("%w: %s", json.ErrUnexpectedType, path)
+	}
+
+	return nil
+}
+
 func (p *ObjectProxy) setInternal(
 	k string,
 	creator func(ticket *time.Ticket) json.Element,
@@ -188,15 +407,64 @@ func (p *ObjectProxy) setInternal(
 	proxy := creator(ticket)
 	value := toOriginal(proxy)
 
+	// NOTE: The values of the key including concurrent ones are overwritten
+	// by this set because the user has seen them. A nil observed means that
+	// the last writer wins, so it is not used even if there are no values.
+	observed := []*time.Ticket{}
+	for _, elem := range p.Object.Conflicts(k) {
+		observed = append(observed, elem.CreatedAt())
+	}
+
 	p.context.Push(operation.NewSet(
 		p.CreatedAt(),
 		k,
 		value.Deepcopy(),
+		observed,
 		ticket,
 	))
 
-	p.Set(k, value)
-	p.context.RegisterElement(value)
+	removed := p.SetWithObserved(k, value, observed)
+	p.context.RegisterElement(p.Object, value)
+	for _, elem := range removed {
+		p.context.RegisterRemovedElementPair(p.Object, elem)
+	}
 
 	return proxy
 }
+
+// fromJSONValue converts the numbers decoded as gojson.Number in the given
+// value into int, int64 or float64.
+func fromJSONValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, member := range v {
+			value, err := fromJSONValue(member)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = value
+		}
+		return v, nil
+	case []interface{}:
+		for i, element := range v {
+			value, err := fromJSONValue(element)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = value
+		}
+		return v, nil
+	case gojson.Number:
+		if !strings.ContainsAny(v.String(), ".eE") {
+			if n, err := v.Int64(); err == nil {
+				if n >= math.MinInt32 && n <= math.MaxInt32 {
+					return int(n), nil
+				}
+				return n, nil
+			}
+		}
+		return v.Float64()
+	}
+
+	return v, nil
+}
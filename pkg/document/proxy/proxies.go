@@ -17,9 +17,17 @@
 package proxy
 
 import (
+	"errors"
+	time2 "time"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 )
 
+// ErrUnsupportedValue is returned when the given value can not be stored as a
+// primitive.
+var ErrUnsupportedValue = errors.New("unsupported value")
+
 func toOriginal(elem json.Element) json.Element {
 	switch elem := elem.(type) {
 	case *ObjectProxy:
@@ -28,9 +36,38 @@ func toOriginal(elem json.Element) json.Element {
 		return elem.Array
 	case *TextProxy:
 		return elem.Text
-	case *json.Primitive:
+	case *CounterProxy:
+		return elem.Counter
+	case *json.Object, *json.Array, *json.Text, *json.Counter, *json.Primitive:
 		return elem
 	}
 
 	panic("unsupported type")
 }
+
+// toProxy wraps the given element with its proxy so that it can be edited.
+func toProxy(ctx *change.Context, elem json.Element) json.Element {
+	switch elem := elem.(type) {
+	case *json.Object:
+		return NewObjectProxy(ctx, elem)
+	case *json.Array:
+		return NewArrayProxy(ctx, elem)
+	case *json.Text:
+		return NewTextProxy(ctx, elem)
+	case *json.Counter:
+		return NewCounterProxy(ctx, elem)
+	}
+
+	return elem
+}
+
+// isPrimitiveValue returns whether the given value can be stored as a
+// primitive or not.
+func isPrimitiveValue(v interface{}) bool {
+	switch v.(type) {
+	case nil, bool, int, int64, float64, string, []byte, time2.Time:
+		return true
+	}
+
+	return false
+}
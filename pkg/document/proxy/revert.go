@@ -0,0 +1,593 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package proxy
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operation"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// Reverter pushes the operations that revert the given operations. The
+// operations are computed against the current state of the root, so elements
+// changed by others in the meantime are left as they are.
+//
+// Because there is no operation to restore a removed element, the reverter
+// restores a copy of it with new time tickets instead. It keeps track of the
+// copies so that the older operations can be reverted on them later.
+type Reverter struct {
+	copiesMapByCreatedAt map[string][]*time.Ticket
+
+	// nodeCopyMap is a map of the text nodes to the creation time of their
+	// copies. It is used to find the positions in the copied text.
+	nodeCopyMap map[string]*time.Ticket
+}
+
+// textEdit is an edit of Text by indexes to revert an Edit.
+type textEdit struct {
+	from    int
+	to      int
+	content string
+
+	// origin is the creation time of the removed nodes to be restored by
+	// this edit, and nodes are the removed nodes.
+	origin *time.Ticket
+	nodes  []*json.TextNode
+}
+
+// NewReverter creates a new instance of Reverter.
+func NewReverter() *Reverter {
+	return &Reverter{
+		copiesMapByCreatedAt: make(map[string][]*time.Ticket),
+		nodeCopyMap:          make(map[string]*time.Ticket),
+	}
+}
+
+// Revert pushes the operations that revert the given operations into the
+// given context in reverse order. Operations other than Set, Add, Remove and
+// Edit are ignored.
+func (r *Reverter) Revert(ctx *change.Context, ops []operation.Operation) {
+	for i := len(ops) - 1; i >= 0; i-- {
+		switch op := ops[i].(type) {
+		case *operation.Set:
+			r.revertSet(ctx, op)
+		case *operation.Add:
+			r.revertAdd(ctx, op)
+		case *operation.Remove:
+			r.revertRemove(ctx, op)
+		case *operation.Edit:
+			r.revertEdit(ctx, op)
+		}
+	}
+}
+
+// revertSet restores the value replaced by the given Set, or removes the
+// value if there was none.
+func (r *Reverter) revertSet(ctx *change.Context, op *operation.Set) {
+	obj, ok := r.find(ctx, op.ParentCreatedAt()).(*json.Object)
+	if !ok || obj.DeletedAt() != nil {
+		return
+	}
+
+	value := r.find(ctx, op.Value().CreatedAt())
+	if value == nil || value.DeletedAt() != nil {
+		return
+	}
+
+	p := NewObjectProxy(ctx, obj)
+	if prev := r.replacedBy(ctx, op); prev != nil {
+		r.setCopy(p, op.Key(), prev)
+		return
+	}
+
+	p.removeByCreatedAt(value.CreatedAt())
+}
+
+// replacedBy returns the element replaced by the given Set. RHT marks the
+// replaced element as deleted at the creation time of the new one.
+func (r *Reverter) replacedBy(ctx *change.Context, op *operation.Set) json.Element {
+	for _, createdAt := range r.copiesOf(op.ParentCreatedAt()) {
+		obj, ok := ctx.FindByCreatedAt(createdAt).(*json.Object)
+		if !ok {
+			continue
+		}
+
+		for _, node := range obj.RHTNodes() {
+			elem := node.Element()
+			if node.Key() == op.Key() && elem.DeletedAt() != nil &&
+				elem.DeletedAt().Compare(op.Value().CreatedAt()) == 0 {
+				return elem
+			}
+		}
+	}
+
+	return nil
+}
+
+// revertAdd removes the value added by the given Add.
+func (r *Reverter) revertAdd(ctx *change.Context, op *operation.Add) {
+	arr, ok := r.find(ctx, op.ParentCreatedAt()).(*json.Array)
+	if !ok || arr.DeletedAt() != nil {
+		return
+	}
+
+	value := r.find(ctx, op.Value().CreatedAt())
+	if value == nil || value.DeletedAt() != nil {
+		return
+	}
+
+	NewArrayProxy(ctx, arr).removeByCreatedAt(value.CreatedAt())
+}
+
+// revertRemove restores a copy of the element removed by the given Remove at
+// its previous position.
+func (r *Reverter) revertRemove(ctx *change.Context, op *operation.Remove) {
+	elem := r.find(ctx, op.CreatedAt())
+	if elem == nil || elem.DeletedAt() == nil {
+		return
+	}
+
+	switch parent := r.find(ctx, op.ParentCreatedAt()).(type) {
+	case *json.Object:
+		if parent.DeletedAt() != nil {
+			return
+		}
+
+		// NOTE: If someone has set the key in the meantime, keep it.
+		key, ok := r.keyOf(ctx, op.ParentCreatedAt(), elem)
+		if !ok || parent.Has(key) {
+			return
+		}
+
+		r.setCopy(NewObjectProxy(ctx, parent), key, elem)
+	case *json.Array:
+		if parent.DeletedAt() != nil {
+			return
+		}
+
+		r.insertCopyAfter(
+			NewArrayProxy(ctx, parent),
+			r.prevCreatedAtOf(ctx, op.ParentCreatedAt(), parent, elem),
+			elem,
+		)
+	}
+}
+
+// keyOf returns the key of the given element in the object of the given
+// creation time or in its copies.
+func (r *Reverter) keyOf(
+	ctx *change.Context,
+	parentCreatedAt *time.Ticket,
+	elem json.Element,
+) (string, bool) {
+	for _, createdAt := range r.copiesOf(parentCreatedAt) {
+		obj, ok := ctx.FindByCreatedAt(createdAt).(*json.Object)
+		if !ok {
+			continue
+		}
+
+		for _, node := range obj.RHTNodes() {
+			if node.Element() == elem {
+				return node.Key(), true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// prevCreatedAtOf returns the creation time of the element in the given array
+// after which the copy of the given element should be inserted. The given
+// element may be in a different array than the given one if the array has
+// been restored as a copy.
+func (r *Reverter) prevCreatedAtOf(
+	ctx *change.Context,
+	parentCreatedAt *time.Ticket,
+	arr *json.Array,
+	elem json.Element,
+) *time.Ticket {
+	for _, createdAt := range r.copiesOf(parentCreatedAt) {
+		container, ok := ctx.FindByCreatedAt(createdAt).(*json.Array)
+		if !ok {
+			continue
+		}
+
+		nodes := container.Nodes()
+		idx := indexOfElement(nodes, elem)
+		if idx < 0 {
+			continue
+		}
+
+		if container == arr {
+			prevCreatedAt, err := arr.PrevCreatedAt(elem.CreatedAt(), nil)
+			if err != nil {
+				return arr.LastCreatedAt()
+			}
+			return prevCreatedAt
+		}
+
+		for i := idx - 1; i >= 0; i-- {
+			prev := r.find(ctx, nodes[i].Element().CreatedAt())
+			if prev != nil && prev.DeletedAt() == nil && indexOfElement(arr.Nodes(), prev) >= 0 {
+				return prev.CreatedAt()
+			}
+		}
+		return arr.FirstCreatedAt()
+	}
+
+	return arr.LastCreatedAt()
+}
+
+// revertEdit removes the content inserted by the given Edit and restores the
+// content removed by it.
+func (r *Reverter) revertEdit(ctx *change.Context, op *operation.Edit) {
+	text, ok := r.find(ctx, op.ParentCreatedAt()).(*json.Text)
+	if !ok || text.DeletedAt() != nil {
+		return
+	}
+
+	inserted := make(map[string]bool)
+	for _, createdAt := range r.copiesOf(op.ExecutedAt()) {
+		inserted[createdAt.Key()] = true
+	}
+
+	// 01. collect the edits to remove the inserted content from the text.
+	var edits []*textEdit
+	endIndexMap := make(map[string]int)
+	liveMap := make(map[string]bool)
+	index := 0
+	for _, node := range text.TextNodes() {
+		createdAt := node.ID().CreatedAt()
+		if node.DeletedAt() == nil {
+			liveMap[createdAt.Key()] = true
+		}
+		if node.DeletedAt() == nil && inserted[createdAt.Key()] {
+			if last := lastTextEdit(edits); last != nil && last.content == "" && last.to == index {
+				last.to += node.Len()
+			} else {
+				edits = append(edits, &textEdit{from: index, to: index + node.Len()})
+			}
+		}
+
+		index += node.Len()
+		endIndexMap[createdAt.Key()] = index
+	}
+
+	// 02. collect the edits to restore the removed content. If the text has
+	// been restored as a copy, the removed nodes are in the original text, so
+	// their positions are found by the copies of the preceding nodes. Nodes
+	// which already have a live copy are skipped.
+	for _, createdAt := range r.copiesOf(op.ParentCreatedAt()) {
+		origin, ok := ctx.FindByCreatedAt(createdAt).(*json.Text)
+		if !ok {
+			continue
+		}
+
+		var restores []*textEdit
+		index := 0
+		nodes := origin.TextNodes()
+		for i, node := range nodes {
+			if node.DeletedAt() == nil || node.DeletedAt().Compare(op.ExecutedAt()) != 0 {
+				index += node.Len()
+				continue
+			}
+
+			if ticket, ok := r.nodeCopyMap[textNodeKey(node)]; ok && liveMap[ticket.Key()] {
+				continue
+			}
+
+			from := index
+			if origin != text {
+				from = r.copiedIndexOf(nodes[:i], endIndexMap)
+			}
+
+			last := lastTextEdit(restores)
+			if last != nil && last.from == from && last.origin.Compare(node.ID().CreatedAt()) == 0 {
+				last.content += node.String()
+				last.nodes = append(last.nodes, node)
+				continue
+			}
+
+			restores = append(restores, &textEdit{
+				from:    from,
+				to:      from,
+				content: node.String(),
+				origin:  node.ID().CreatedAt(),
+				nodes:   []*json.TextNode{node},
+			})
+		}
+		edits = append(edits, restores...)
+	}
+
+	// 03. apply the edits from the end so that the indexes of the preceding
+	// edits are not shifted. At the same index, a removal is applied before
+	// an insertion so that the inserted content is not removed.
+	sort.SliceStable(edits, func(i, j int) bool {
+		if edits[i].from != edits[j].from {
+			return edits[i].from < edits[j].from
+		}
+		return edits[i].content != "" && edits[j].content == ""
+	})
+
+	p := NewTextProxy(ctx, text)
+	for i := len(edits) - 1; i >= 0; i-- {
+		edit := edits[i]
+		ticket := p.edit(edit.from, edit.to, edit.content)
+		if edit.origin != nil {
+			r.addCopy(edit.origin, ticket)
+		}
+		for _, node := range edit.nodes {
+			r.nodeCopyMap[textNodeKey(node)] = ticket
+		}
+	}
+}
+
+// copiedIndexOf returns the index right after the copy of the last node among
+// the given nodes that has been copied, using the given map of the end index
+// of each node in the copied text.
+func (r *Reverter) copiedIndexOf(nodes []*json.TextNode, endIndexMap map[string]int) int {
+	for i := len(nodes) - 1; i >= 0; i-- {
+		ticket, ok := r.nodeCopyMap[textNodeKey(nodes[i])]
+		if !ok {
+			continue
+		}
+
+		if index, ok := endIndexMap[ticket.Key()]; ok {
+			return index
+		}
+	}
+
+	return 0
+}
+
+// setCopy sets a copy of the given element with new time tickets. The members
+// of the element are copied with their own operations.
+func (r *Reverter) setCopy(p *ObjectProxy, k string, elem json.Element) {
+	var copied json.Element
+	switch elem := elem.(type) {
+	case *json.Primitive:
+		copied = p.setInternal(k, func(ticket *time.Ticket) json.Element {
+			return json.NewPrimitive(elem.Value(), ticket)
+		})
+	case *json.Object:
+		obj := p.SetNewObject(k)
+		r.copyMembers(obj, elem)
+		copied = obj
+	case *json.Array:
+		arr := p.SetNewArray(k)
+		r.copyElements(arr, elem)
+		copied = arr
+	case *json.Text:
+		text := p.SetNewText(k)
+		r.copyContent(text, elem)
+		copied = text
+	case *json.Counter:
+		copied = p.SetNewCounter(k, elem.Value())
+	default:
+		panic("unsupported type")
+	}
+
+	r.addCopy(elem.CreatedAt(), copied.CreatedAt())
+}
+
+// insertCopyAfter inserts a copy of the given element with new time tickets
+// after the element of the given prevCreatedAt.
+func (r *Reverter) insertCopyAfter(
+	p *ArrayProxy,
+	prevCreatedAt *time.Ticket,
+	elem json.Element,
+) {
+	var creator func(ticket *time.Ticket) json.Element
+	switch elem := elem.(type) {
+	case *json.Primitive:
+		creator = func(ticket *time.Ticket) json.Element {
+			return json.NewPrimitive(elem.Value(), ticket)
+		}
+	case *json.Object:
+		creator = func(ticket *time.Ticket) json.Element {
+			return NewObjectProxy(p.context, json.NewObject(json.NewRHT(), ticket))
+		}
+	case *json.Array:
+		creator = func(ticket *time.Ticket) json.Element {
+			return NewArrayProxy(p.context, json.NewArray(json.NewRGA(), ticket))
+		}
+	case *json.Text:
+		creator = func(ticket *time.Ticket) json.Element {
+			return NewTextProxy(p.context, json.NewText(json.NewRGATreeSplit(), ticket))
+		}
+	case *json.Counter:
+		creator = func(ticket *time.Ticket) json.Element {
+			return NewCounterProxy(p.context, json.NewCounter(elem.Value(), ticket))
+		}
+	default:
+		panic("unsupported type")
+	}
+
+	copied, err := p.insertAfterInternal(prevCreatedAt, creator)
+	if err != nil {
+		return
+	}
+
+	switch elem := elem.(type) {
+	case *json.Object:
+		r.copyMembers(copied.(*ObjectProxy), elem)
+	case *json.Array:
+		r.copyElements(copied.(*ArrayProxy), elem)
+	case *json.Text:
+		r.copyContent(copied.(*TextProxy), elem)
+	}
+
+	r.addCopy(elem.CreatedAt(), copied.CreatedAt())
+}
+
+// copyMembers copies the members of the given object. The members removed
+// together with the object are also copied to restore the object as it was.
+func (r *Reverter) copyMembers(p *ObjectProxy, obj *json.Object) {
+	members := obj.Members()
+	for _, node := range obj.RHTNodes() {
+		elem := node.Element()
+		if _, ok := members[node.Key()]; ok && members[node.Key()].DeletedAt() == nil {
+			continue
+		}
+
+		if isRemovedTogether(elem.DeletedAt(), obj.DeletedAt()) {
+			if member, ok := members[node.Key()]; !ok || elem.CreatedAt().After(member.CreatedAt()) {
+				members[node.Key()] = elem
+			}
+		}
+	}
+
+	var keys []string
+	for key := range members {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		r.setCopy(p, key, members[key])
+	}
+}
+
+// copyElements copies the elements of the given array. The elements removed
+// together with the array are also copied.
+func (r *Reverter) copyElements(p *ArrayProxy, arr *json.Array) {
+	for _, node := range arr.Nodes() {
+		elem := node.Element()
+		if elem.DeletedAt() == nil || isRemovedTogether(elem.DeletedAt(), arr.DeletedAt()) {
+			r.insertCopyAfter(p, p.Array.LastCreatedAt(), elem)
+		}
+	}
+}
+
+// copyContent copies the content of the given text node by node. The content
+// removed together with the text is also copied.
+func (r *Reverter) copyContent(p *TextProxy, text *json.Text) {
+	index := 0
+	for _, node := range text.TextNodes() {
+		if node.DeletedAt() != nil && !isRemovedTogether(node.DeletedAt(), text.DeletedAt()) {
+			continue
+		}
+
+		ticket := p.edit(index, index, node.String())
+		index += len([]rune(node.String()))
+		r.addCopy(node.ID().CreatedAt(), ticket)
+		r.nodeCopyMap[textNodeKey(node)] = ticket
+	}
+}
+
+// find returns the element of the given creation time. If the element has
+// been restored as a copy, it returns the last copy.
+func (r *Reverter) find(ctx *change.Context, createdAt *time.Ticket) json.Element {
+	for {
+		copies := r.copiesMapByCreatedAt[createdAt.Key()]
+		if len(copies) == 0 {
+			break
+		}
+		createdAt = copies[len(copies)-1]
+	}
+
+	return ctx.FindByCreatedAt(createdAt)
+}
+
+// copiesOf returns the given creation time and the creation times of all its
+// copies.
+func (r *Reverter) copiesOf(createdAt *time.Ticket) []*time.Ticket {
+	visited := map[string]bool{createdAt.Key(): true}
+	copies := []*time.Ticket{createdAt}
+	for i := 0; i < len(copies); i++ {
+		for _, copied := range r.copiesMapByCreatedAt[copies[i].Key()] {
+			if !visited[copied.Key()] {
+				visited[copied.Key()] = true
+				copies = append(copies, copied)
+			}
+		}
+	}
+
+	return copies
+}
+
+func (r *Reverter) addCopy(origin, copied *time.Ticket) {
+	if origin.Compare(copied) == 0 {
+		return
+	}
+
+	key := origin.Key()
+	r.copiesMapByCreatedAt[key] = append(r.copiesMapByCreatedAt[key], copied)
+}
+
+// isRemovedTogether returns whether the given two deletion times are issued by
+// the same change.
+func isRemovedTogether(deletedAt, otherDeletedAt *time.Ticket) bool {
+	if deletedAt == nil || otherDeletedAt == nil {
+		return false
+	}
+
+	return deletedAt.Lamport() == otherDeletedAt.Lamport() &&
+		deletedAt.ActorID().Compare(otherDeletedAt.ActorID()) == 0
+}
+
+func lastTextEdit(edits []*textEdit) *textEdit {
+	if len(edits) == 0 {
+		return nil
+	}
+
+	return edits[len(edits)-1]
+}
+
+func textNodeKey(node *json.TextNode) string {
+	return fmt.Sprintf("%s:%d", node.ID().CreatedAt().Key(), node.ID().Offset())
+}
+
+func indexOfElement(nodes []*json.RGANode, elem json.Element) int {
+	for i, node := range nodes {
+		if node.Element() == elem {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// removeByCreatedAt removes the element of the given creation time.
+func (p *ObjectProxy) removeByCreatedAt(createdAt *time.Ticket) {
+	ticket := p.context.IssueTimeTicket()
+	removed := p.Object.RemoveByCreatedAt(createdAt, ticket)
+	p.context.Push(operation.NewRemove(
+		p.CreatedAt(),
+		createdAt,
+		nil,
+		ticket,
+	))
+	p.context.RegisterRemovedElementPair(p.Object, removed)
+}
+
+// removeByCreatedAt removes the element of the given creation time.
+func (p *ArrayProxy) removeByCreatedAt(createdAt *time.Ticket) {
+	ticket := p.context.IssueTimeTicket()
+	removed := p.Array.RemoveByCreatedAt(createdAt, ticket)
+	p.context.Push(operation.NewRemove(
+		p.CreatedAt(),
+		createdAt,
+		nil,
+		ticket,
+	))
+	p.context.RegisterRemovedElementPair(p.Array, removed)
+}
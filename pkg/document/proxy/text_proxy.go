@@ -20,6 +20,7 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/operation"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/log"
 )
 
@@ -36,6 +37,13 @@ func NewTextProxy(ctx *change.Context, text *json.Text) *TextProxy {
 }
 
 func (p *TextProxy) Edit(from, to int, content string) *TextProxy {
+	p.edit(from, to, content)
+	return p
+}
+
+// edit edits the given range with the given content and returns the time
+// ticket of the edit.
+func (p *TextProxy) edit(from, to int, content string) *time.Ticket {
 	if from > to {
 		panic("from should be less than or equal to to")
 	}
@@ -46,13 +54,16 @@ func (p *TextProxy) Edit(from, to int, content string) *TextProxy {
 	)
 
 	ticket := p.context.IssueTimeTicket()
-	_, maxCreationMapByActor := p.Text.Edit(
+	_, maxCreationMapByActor, _ := p.Text.Edit(
 		fromPos,
 		toPos,
 		nil,
 		content,
 		ticket,
 	)
+	if p.Text.GarbageLen() > 0 {
+		p.context.RegisterTextWithGarbage(p.Text)
+	}
 
 	p.context.Push(operation.NewEdit(
 		p.CreatedAt(),
@@ -63,5 +74,66 @@ func (p *TextProxy) Edit(from, to int, content string) *TextProxy {
 		ticket,
 	))
 
+	return ticket
+}
+
+// Style applies the given attributes to the given range.
+func (p *TextProxy) Style(from, to int, attributes map[string]string) *TextProxy {
+	if from > to {
+		panic("from should be less than or equal to to")
+	}
+	fromPos, toPos := p.Text.CreateRange(from, to)
+	log.Logger.Debugf(
+		"STYL: f:%d->%s, t:%d->%s a:%v",
+		from, fromPos.AnnotatedString(), to, toPos.AnnotatedString(), attributes,
+	)
+
+	ticket := p.context.IssueTimeTicket()
+	maxCreationMapByActor := p.Text.Style(
+		fromPos,
+		toPos,
+		nil,
+		attributes,
+		ticket,
+	)
+
+	p.context.Push(operation.NewStyle(
+		p.CreatedAt(),
+		fromPos,
+		toPos,
+		maxCreationMapByActor,
+		attributes,
+		ticket,
+	))
+
+	return p
+}
+
+// Select selects the given range of this text. The selection is shared with
+// other peers to show their carets.
+func (p *TextProxy) Select(from, to int) *TextProxy {
+	if from > to {
+		panic("from should be less than or equal to to")
+	}
+	fromPos, toPos := p.Text.CreateRange(from, to)
+	log.Logger.Debugf(
+		"SELT: f:%d->%s, t:%d->%s",
+		from, fromPos.AnnotatedString(), to, toPos.AnnotatedString(),
+	)
+
+	ticket := p.context.IssueTimeTicket()
+	p.Text.Select(
+		fromPos,
+		toPos,
+		ticket,
+	)
+
+	p.context.Push(operation.NewSelect(
+		p.CreatedAt(),
+		fromPos,
+		toPos,
+		ticket,
+	))
+
 	return p
 }
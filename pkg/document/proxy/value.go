@@ -0,0 +1,233 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package proxy
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	time2 "time"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+type valueKind int
+
+const (
+	primitiveValue valueKind = iota
+	objectValue
+	arrayValue
+	textValue
+)
+
+var timeType = reflect.TypeOf(time2.Time{})
+
+// value is a Go value converted to be stored in the document. Converting
+// values before creating elements lets us report unsupported values without
+// pushing any operations.
+type value struct {
+	kind      valueKind
+	primitive interface{}
+	text      string
+	keys      []string
+	members   map[string]*value
+	elements  []*value
+}
+
+// toValue converts the given Go value into a value. Maps with string keys,
+// structs, slices and primitives are allowed.
+func toValue(v interface{}) (*value, error) {
+	return toReflectValue(reflect.ValueOf(v), "$")
+}
+
+func toReflectValue(v reflect.Value, path string) (*value, error) {
+	if !v.IsValid() {
+		return &value{kind: primitiveValue}, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return &value{kind: primitiveValue}, nil
+		}
+		return toReflectValue(v.Elem(), path)
+	case reflect.Bool:
+		return &value{kind: primitiveValue, primitive: v.Bool()}, nil
+	case reflect.Int64:
+		return &value{kind: primitiveValue, primitive: v.Int()}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return &value{kind: primitiveValue, primitive: fromInt64(v.Int())}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.Uint() > math.MaxInt64 {
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedValue, path)
+		}
+		if v.Kind() == reflect.Uint64 {
+			return &value{kind: primitiveValue, primitive: int64(v.Uint())}, nil
+		}
+		return &value{kind: primitiveValue, primitive: fromInt64(int64(v.Uint()))}, nil
+	case reflect.Float32, reflect.Float64:
+		return &value{kind: primitiveValue, primitive: v.Float()}, nil
+	case reflect.String:
+		return &value{kind: primitiveValue, primitive: v.String()}, nil
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedValue, path)
+		}
+
+		val := &value{kind: objectValue, members: make(map[string]*value)}
+		iter := v.MapRange()
+		for iter.Next() {
+			key := iter.Key().String()
+			member, err := toReflectValue(iter.Value(), json.MemberPath(path, key))
+			if err != nil {
+				return nil, err
+			}
+			val.addMember(key, member)
+		}
+		sort.Strings(val.keys)
+		return val, nil
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return &value{kind: primitiveValue, primitive: v.Interface()}, nil
+		}
+
+		val := &value{kind: objectValue, members: make(map[string]*value)}
+		for _, field := range json.StructFields(v.Type()) {
+			fieldValue := v.Field(field.Index)
+			if field.OmitEmpty && fieldValue.IsZero() {
+				continue
+			}
+
+			if field.IsText {
+				val.addMember(field.Key, &value{kind: textValue, text: fieldValue.String()})
+				continue
+			}
+
+			member, err := toReflectValue(fieldValue, json.MemberPath(path, field.Key))
+			if err != nil {
+				return nil, err
+			}
+			val.addMember(field.Key, member)
+		}
+		sort.Strings(val.keys)
+		return val, nil
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			return &value{kind: primitiveValue, primitive: v.Bytes()}, nil
+		}
+
+		val := &value{kind: arrayValue}
+		for i := 0; i < v.Len(); i++ {
+			element, err := toReflectValue(v.Index(i), json.ElementPath(path, i))
+			if err != nil {
+				return nil, err
+			}
+			val.elements = append(val.elements, element)
+		}
+		return val, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedValue, path)
+}
+
+func (v *value) addMember(key string, member *value) {
+	v.keys = append(v.keys, key)
+	v.members[key] = member
+}
+
+// equals returns whether the given element has the same content as this
+// value or not.
+func (v *value) equals(elem json.Element) bool {
+	switch elem := elem.(type) {
+	case *json.Primitive:
+		if v.kind != primitiveValue {
+			return false
+		}
+
+		switch primitive := v.primitive.(type) {
+		case int, int64:
+			n, _ := toInt64(primitive)
+			other, ok := toInt64(elem.Value())
+			return ok && n == other
+		case time2.Time:
+			other, ok := elem.Value().(time2.Time)
+			return ok && primitive.Equal(other)
+		case []byte:
+			other, ok := elem.Value().([]byte)
+			return ok && bytes.Equal(primitive, other)
+		}
+		return v.primitive == elem.Value()
+	case *json.Text:
+		return v.kind == textValue && v.text == elem.String()
+	}
+
+	return false
+}
+
+// buildElement creates the element of the given value. The descendants are
+// created with the time tickets issued by the given context.
+func buildElement(ctx *change.Context, v *value, ticket *time.Ticket) json.Element {
+	switch v.kind {
+	case objectValue:
+		obj := json.NewObject(json.NewRHT(), ticket)
+		for _, key := range v.keys {
+			obj.Set(key, buildElement(ctx, v.members[key], ctx.IssueTimeTicket()))
+		}
+		return obj
+	case arrayValue:
+		arr := json.NewArray(json.NewRGA(), ticket)
+		for _, element := range v.elements {
+			arr.Add(buildElement(ctx, element, ctx.IssueTimeTicket()))
+		}
+		return arr
+	case textValue:
+		text := json.NewText(json.NewRGATreeSplit(), ticket)
+		if v.text != "" {
+			from, to := text.CreateRange(0, 0)
+			text.Edit(from, to, nil, v.text, ctx.IssueTimeTicket())
+		}
+		return text
+	}
+
+	return json.NewPrimitive(v.primitive, ticket)
+}
+
+// toInt64 returns the given Integer or Long value as int64.
+func toInt64(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case int:
+		return int64(n), true
+	case int64:
+		return n, true
+	}
+
+	return 0, false
+}
+
+// fromInt64 returns the given integer as int if it fits in Integer, otherwise
+// as int64.
+func fromInt64(n int64) interface{} {
+	if n >= math.MinInt32 && n <= math.MaxInt32 {
+		return int(n)
+	}
+
+	return n
+}
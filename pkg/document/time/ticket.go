@@ -21,6 +21,11 @@ import (
 	"math"
 )
 
+const (
+	// MaxDelimiter is the maximum value of the delimiter.
+	MaxDelimiter = math.MaxUint32
+)
+
 var (
 	InitialTicket = NewTicket(
 		0,
@@ -29,7 +34,7 @@ var (
 	)
 	MaxTicket = NewTicket(
 		math.MaxUint64,
-		math.MaxUint32,
+		MaxDelimiter,
 		MaxActorID,
 	)
 )
@@ -84,7 +84,22 @@ func (t *Tree) String() string {
 	return strings.Join(str, ",")
 }
 
+// Values returns the values of this tree in the order of their keys.
+func (t *Tree) Values() []Value {
+	var values []Value
+	traverseInOrder(t.root, func(node *Node) {
+		values = append(values, node.value)
+	})
+	return values
+}
+
+// Remove removes the value of the given key. It does nothing if the given key
+// does not exist in this tree.
 func (t *Tree) Remove(key Key) {
+	if !t.has(key) {
+		return
+	}
+
 	if !isRed(t.root.left) && !isRed(t.root.right) {
 		t.root.isRed = true
 	}
@@ -119,7 +134,9 @@ func (t *Tree) Floor(key Key) (Key, Value) {
 					parent = parent.parent
 				}
 
-				// TODO check below
+				if parent == nil {
+					return nil, nil
+				}
 				return parent.key, parent.value
 			}
 		} else {
@@ -130,6 +147,27 @@ func (t *Tree) Floor(key Key) (Key, Value) {
 	return nil, nil
 }
 
+// Len returns the number of nodes in this tree.
+func (t *Tree) Len() int {
+	return t.size
+}
+
+func (t *Tree) has(key Key) bool {
+	node := t.root
+	for node != nil {
+		compare := key.Compare(node.key)
+		if compare == 0 {
+			return true
+		} else if compare < 0 {
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+
+	return false
+}
+
 func (t *Tree) put(node *Node, key Key, value Value) *Node {
 	if node == nil {
 		t.size++
@@ -92,4 +92,33 @@ func TestTree(t *testing.T) {
 		tree.Remove(newIntKey(5))
 		assert.Equal(t, "0,1,3,4,6,7,9", tree.String())
 	})
+
+	t.Run("remove test", func(t *testing.T) {
+		tree := llrb.NewTree()
+		tree.Remove(newIntKey(0))
+		assert.Equal(t, 0, tree.Len())
+
+		for _, value := range shuffle(rangeArray(0, 99)) {
+			tree.Put(newIntKey(value), newIntValue(value))
+		}
+		assert.Equal(t, 100, tree.Len())
+
+		tree.Remove(newIntKey(100))
+		assert.Equal(t, 100, tree.Len())
+
+		for _, value := range shuffle(rangeArray(0, 99)) {
+			tree.Remove(newIntKey(value))
+			tree.Remove(newIntKey(value))
+		}
+		assert.Equal(t, 0, tree.Len())
+		assert.Equal(t, "", tree.String())
+
+		tree.Put(newIntKey(3), newIntValue(3))
+		tree.Put(newIntKey(5), newIntValue(5))
+		key, _ := tree.Floor(newIntKey(1))
+		assert.Nil(t, key)
+		key, value := tree.Floor(newIntKey(4))
+		assert.Equal(t, "3", value.String())
+		assert.Equal(t, 0, key.Compare(newIntKey(3)))
+	})
 }
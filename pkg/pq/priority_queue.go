@@ -19,7 +19,8 @@ package pq
 import "container/heap"
 
 type PriorityQueue struct {
-	queue *internalQueue
+	queue        *internalQueue
+	itemsByValue map[Value]*Item
 }
 
 func NewPriorityQueue() *PriorityQueue {
@@ -27,7 +28,8 @@ func NewPriorityQueue() *PriorityQueue {
 	heap.Init(pq)
 
 	return &PriorityQueue{
-		queue: pq,
+		queue:        pq,
+		itemsByValue: make(map[Value]*Item),
 	}
 }
 
@@ -36,12 +38,31 @@ func (pq *PriorityQueue) Peek() Value {
 }
 
 func (pq *PriorityQueue) Pop() Value {
-	return heap.Pop(pq.queue).(*Item).value
+	value := heap.Pop(pq.queue).(*Item).value
+	delete(pq.itemsByValue, value)
+	return value
 }
 
 func (pq *PriorityQueue) Push(value Value) {
 	item := NewItem(value)
 	heap.Push(pq.queue, item)
+	pq.itemsByValue[value] = item
+}
+
+// Release removes the given value from this queue.
+func (pq *PriorityQueue) Release(value Value) {
+	item, ok := pq.itemsByValue[value]
+	if !ok {
+		return
+	}
+
+	heap.Remove(pq.queue, item.index)
+	delete(pq.itemsByValue, value)
+}
+
+// Len returns the number of values in this queue.
+func (pq *PriorityQueue) Len() int {
+	return pq.queue.Len()
 }
 
 func (pq *PriorityQueue) Values() []Value {
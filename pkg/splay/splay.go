@@ -75,6 +75,12 @@ func (n *Node) increaseWeight(weight int) {
 	n.weight += weight
 }
 
+func (n *Node) unlink() {
+	n.parent = nil
+	n.left = nil
+	n.right = nil
+}
+
 // Tree is weighted binary search tree which is based on Splay tree.
 // original paper on Splay Trees:
 //  - https://www.cs.cmu.edu/~sleator/papers/self-adjusting.pdf
@@ -148,6 +154,38 @@ func (t *Tree) Splay(node *Node) {
 	}
 }
 
+// Delete deletes the given node from this Tree.
+func (t *Tree) Delete(node *Node) {
+	t.Splay(node)
+
+	leftTree := NewTree()
+	if node.left != nil {
+		leftTree.root = node.left
+		node.left.parent = nil
+	}
+
+	rightTree := NewTree()
+	if node.right != nil {
+		rightTree.root = node.right
+		node.right.parent = nil
+	}
+
+	if leftTree.root != nil {
+		maxNode := leftTree.maximum()
+		leftTree.Splay(maxNode)
+		leftTree.root.right = rightTree.root
+		if rightTree.root != nil {
+			rightTree.root.parent = leftTree.root
+		}
+		t.root = leftTree.root
+		t.UpdateSubtree(t.root)
+	} else {
+		t.root = rightTree.root
+	}
+
+	node.unlink()
+}
+
 // IndexOf Find the index of the given node.
 func (t *Tree) IndexOf(node *Node) int {
 	if node == nil {
@@ -267,6 +305,14 @@ func (t *Tree) rotateRight(pivot *Node) {
 	t.UpdateSubtree(pivot)
 }
 
+func (t *Tree) maximum() *Node {
+	node := t.root
+	for node.right != nil {
+		node = node.right
+	}
+	return node
+}
+
 func (t *Tree) UpdateSubtree(node *Node) {
 	node.initWeight()
 
@@ -63,4 +63,29 @@ func TestSplayTree(t *testing.T) {
 		assert.Equal(t, tree.IndexOf(nodeC), 5)
 		assert.Equal(t, tree.IndexOf(nodeD), 9)
 	})
+
+	t.Run("delete test", func(t *testing.T) {
+		tree := splay.NewTree()
+
+		nodeA := tree.Insert(newSplayNode("A2"))
+		nodeB := tree.Insert(newSplayNode("B23"))
+		nodeC := tree.Insert(newSplayNode("C234"))
+		nodeD := tree.Insert(newSplayNode("D2345"))
+		assert.Equal(t, "[2,2]A2[5,3]B23[9,4]C234[14,5]D2345", tree.AnnotatedString())
+
+		tree.Delete(nodeC)
+		assert.Equal(t, "A2B23D2345", tree.String())
+		assert.Equal(t, 0, tree.IndexOf(nodeA))
+		assert.Equal(t, 2, tree.IndexOf(nodeB))
+		assert.Equal(t, 5, tree.IndexOf(nodeD))
+
+		tree.Delete(nodeA)
+		assert.Equal(t, "B23D2345", tree.String())
+		assert.Equal(t, 0, tree.IndexOf(nodeB))
+		assert.Equal(t, 3, tree.IndexOf(nodeD))
+
+		tree.Delete(nodeD)
+		tree.Delete(nodeB)
+		assert.Equal(t, "", tree.String())
+	})
 }
@@ -28,6 +28,10 @@ var testStartedAt int64
 const (
 	TestPort               = 1101
 	TestMongoConnectionURI = "mongodb://localhost:27017"
+
+	TestSnapshotThreshold    = 10
+	TestSnapshotInterval     = 10
+	TestMaxOperationsPerPack = 5
 )
 
 func init() {
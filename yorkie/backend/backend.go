@@ -23,22 +23,41 @@ import (
 	"github.com/yorkie-team/yorkie/yorkie/pubsub"
 )
 
+// Config is the configuration for creating a Backend instance.
+type Config struct {
+	// SnapshotThreshold is the threshold that determines if changes should be
+	// sent with snapshot when the number of changes or the number of their
+	// operations is greater than this value.
+	SnapshotThreshold uint64 `json:"SnapshotThreshold"`
+
+	// SnapshotInterval is the interval of changes to create a snapshot. A
+	// snapshot is also created when a push has more operations than this.
+	SnapshotInterval uint64 `json:"SnapshotInterval"`
+
+	// MaxOperationsPerPack is the maximum number of operations of the changes
+	// to push or pull in a pack. A change having more operations than this is
+	// exchanged alone. If it is 0, the number of operations is not limited.
+	MaxOperationsPerPack uint64 `json:"MaxOperationsPerPack"`
+}
+
 // Backend manages Yorkie's remote states such as data store, distributed lock
 // and etc.
 type Backend struct {
+	Config   *Config
 	Mongo    *mongo.Client
 	mutexMap *sync.MutexMap
 	pubSub   *pubsub.PubSub
 }
 
 // New creates a new instance of Backend.
-func New(conf *mongo.Config) (*Backend, error) {
-	client, err := mongo.NewClient(conf)
+func New(conf *Config, mongoConf *mongo.Config) (*Backend, error) {
+	client, err := mongo.NewClient(mongoConf)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Backend{
+		Config:   conf,
 		Mongo:    client,
 		mutexMap: sync.NewMutexMap(),
 		pubSub:   pubsub.NewPubSub(),
@@ -28,6 +28,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/readpref"
 
 	"github.com/yorkie-team/yorkie/pkg/document/change"
+	yorkietime "github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/log"
 	"github.com/yorkie-team/yorkie/yorkie/types"
 )
@@ -286,6 +287,35 @@ func (c *Client) FindDocInfoByKey(
 	return &docInfo, nil
 }
 
+// FindDocInfoByBSONKey returns the document info of the given key without
+// creating or accessing it.
+func (c *Client) FindDocInfoByBSONKey(
+	ctx context.Context,
+	bsonDocKey string,
+) (*types.DocInfo, error) {
+	docInfo := types.DocInfo{}
+
+	if err := c.withCollection(ColDocInfos, func(col *mongo.Collection) error {
+		result := col.FindOne(ctx, bson.M{
+			"key": bsonDocKey,
+		})
+		if result.Err() == mongo.ErrNoDocuments {
+			return ErrDocumentNotFound
+		}
+
+		if err := result.Decode(&docInfo); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &docInfo, nil
+}
+
 func (c *Client) CreateChangeInfos(
 	ctx context.Context,
 	docID primitive.ObjectID,
@@ -403,6 +433,262 @@ func (c *Client) FindChangeInfosBetweenServerSeqs(
 	return changes, nil
 }
 
+// FindChangeInfos returns at most the given number of changes of the given
+// document from the given server seq in order. If the given actor is not nil,
+// only the changes made by the actor are returned.
+func (c *Client) FindChangeInfos(
+	ctx context.Context,
+	docID primitive.ObjectID,
+	from uint64,
+	limit int64,
+	actor *primitive.ObjectID,
+) ([]*change.Change, error) {
+	var changes []*change.Change
+
+	if err := c.withCollection(ColChanges, func(col *mongo.Collection) error {
+		filter := bson.M{
+			"doc_id": docID,
+			"server_seq": bson.M{
+				"$gte": from,
+			},
+		}
+		if actor != nil {
+			filter["actor"] = *actor
+		}
+
+		cursor, err := col.Find(ctx, filter, options.Find().SetSort(bson.M{
+			"server_seq": 1,
+		}).SetLimit(limit))
+		if err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		defer func() {
+			if err := cursor.Close(ctx); err != nil {
+				log.Logger.Error(err)
+			}
+		}()
+
+		for cursor.Next(ctx) {
+			var changeInfo types.ChangeInfo
+			if err := cursor.Decode(&changeInfo); err != nil {
+				log.Logger.Error(err)
+				return err
+			}
+
+			c, err := changeInfo.ToChange()
+			if err != nil {
+				return err
+			}
+			changes = append(changes, c)
+		}
+
+		if cursor.Err() != nil {
+			log.Logger.Error(cursor.Err())
+			return cursor.Err()
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// CreateSnapshotInfo stores the encoded snapshot of the given document at
+// the given server seq.
+func (c *Client) CreateSnapshotInfo(
+	ctx context.Context,
+	docID primitive.ObjectID,
+	serverSeq uint64,
+	snapshot []byte,
+) error {
+	return c.withCollection(ColSnapshots, func(col *mongo.Collection) error {
+		if _, err := col.InsertOne(ctx, bson.M{
+			"doc_id":     docID,
+			"server_seq": serverSeq,
+			"snapshot":   snapshot,
+			"created_at": time.Now(),
+		}); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	})
+}
+
+// FindLastSnapshotInfo returns the last snapshot of the given document. If
+// there is no snapshot, it returns an empty snapshot info.
+func (c *Client) FindLastSnapshotInfo(
+	ctx context.Context,
+	docID primitive.ObjectID,
+) (*types.SnapshotInfo, error) {
+	snapshotInfo := &types.SnapshotInfo{}
+
+	if err := c.withCollection(ColSnapshots, func(col *mongo.Collection) error {
+		result := col.FindOne(ctx, bson.M{
+			"doc_id": docID,
+		}, options.FindOne().SetSort(bson.M{
+			"server_seq": -1,
+		}))
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil
+		}
+
+		if err := result.Decode(snapshotInfo); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return snapshotInfo, nil
+}
+
+// FindClosestSnapshotInfo returns the last snapshot of the given document
+// whose server seq is less than or equal to the given server seq. If there is
+// no such snapshot, it returns an empty snapshot info.
+func (c *Client) FindClosestSnapshotInfo(
+	ctx context.Context,
+	docID primitive.ObjectID,
+	serverSeq uint64,
+) (*types.SnapshotInfo, error) {
+	snapshotInfo := &types.SnapshotInfo{}
+
+	if err := c.withCollection(ColSnapshots, func(col *mongo.Collection) error {
+		result := col.FindOne(ctx, bson.M{
+			"doc_id": docID,
+			"server_seq": bson.M{
+				"$lte": serverSeq,
+			},
+		}, options.FindOne().SetSort(bson.M{
+			"server_seq": -1,
+		}))
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil
+		}
+
+		if err := result.Decode(snapshotInfo); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return snapshotInfo, nil
+}
+
+// FindMinSyncedTicket returns the minimum synced ticket of the given document
+// among the clients who attached it. The elements removed at or before the
+// ticket can be purged because all the clients have seen their removal.
+func (c *Client) FindMinSyncedTicket(
+	ctx context.Context,
+	docID primitive.ObjectID,
+) (*yorkietime.Ticket, error) {
+	var minServerSeq uint64
+
+	if err := c.withCollection(ColClientInfos, func(col *mongo.Collection) error {
+		docKey := "documents." + docID.Hex()
+		result := col.FindOne(ctx, bson.M{
+			docKey + ".status": types.DocumentAttached,
+		}, options.FindOne().SetSort(bson.M{
+			docKey + ".server_seq": 1,
+		}))
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil
+		}
+
+		var clientInfo types.ClientInfo
+		if err := result.Decode(&clientInfo); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		minServerSeq = clientInfo.Documents[docID.Hex()].ServerSeq
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if minServerSeq == 0 {
+		return yorkietime.InitialTicket, nil
+	}
+
+	var changeInfo types.ChangeInfo
+	if err := c.withCollection(ColChanges, func(col *mongo.Collection) error {
+		result := col.FindOne(ctx, bson.M{
+			"doc_id":     docID,
+			"server_seq": minServerSeq,
+		})
+		if err := result.Decode(&changeInfo); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// NOTE: A change pushed after the slowest client has synced, e.g. made
+	// offline and pushed late, can have a smaller lamport. The elements it
+	// removed are not purged until the slowest client pulls it.
+	var unpulledChangeInfo types.ChangeInfo
+	hasUnpulled := false
+	if err := c.withCollection(ColChanges, func(col *mongo.Collection) error {
+		result := col.FindOne(ctx, bson.M{
+			"doc_id": docID,
+			"server_seq": bson.M{
+				"$gt": minServerSeq,
+			},
+		}, options.FindOne().SetSort(bson.M{
+			"lamport": 1,
+		}))
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil
+		}
+		if err := result.Decode(&unpulledChangeInfo); err != nil {
+			log.Logger.Error(err)
+			return err
+		}
+
+		hasUnpulled = true
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if hasUnpulled && unpulledChangeInfo.Lamport <= changeInfo.Lamport {
+		if unpulledChangeInfo.Lamport == 0 {
+			return yorkietime.InitialTicket, nil
+		}
+
+		return yorkietime.NewTicket(
+			unpulledChangeInfo.Lamport-1,
+			yorkietime.MaxDelimiter,
+			yorkietime.MaxActorID,
+		), nil
+	}
+
+	actorID := yorkietime.ActorID{}
+	copy(actorID[:], changeInfo.Actor[:])
+
+	return yorkietime.NewTicket(
+		changeInfo.Lamport,
+		yorkietime.MaxDelimiter,
+		&actorID,
+	), nil
+}
+
 func (c *Client) withCollection(
 	collection string,
 	callback func(collection *mongo.Collection) error,
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/checkpoint"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/testhelper"
+	"github.com/yorkie-team/yorkie/yorkie/backend/mongo"
+	"github.com/yorkie-team/yorkie/yorkie/types"
+)
+
+func TestClient(t *testing.T) {
+	cli, err := mongo.NewClient(&mongo.Config{
+		ConnectionTimeoutSec: 5,
+		PingTimeoutSec:       5,
+		ConnectionURI:        testhelper.TestMongoConnectionURI,
+		YorkieDatabase:       testhelper.TestDBName(),
+	})
+	assert.Nil(t, err)
+	defer func() {
+		assert.Nil(t, cli.Close())
+	}()
+
+	t.Run("min synced ticket with a change pushed late test", func(t *testing.T) {
+		ctx := context.Background()
+
+		attach := func(key string) (*types.ClientInfo, *types.DocInfo) {
+			clientInfo, err := cli.ActivateClient(ctx, key)
+			assert.Nil(t, err)
+			docInfo, err := cli.FindDocInfoByKey(ctx, clientInfo, t.Name(), true)
+			assert.Nil(t, err)
+			assert.Nil(t, clientInfo.AttachDocument(docInfo.ID))
+			return clientInfo, docInfo
+		}
+		sync := func(clientInfo *types.ClientInfo, docInfo *types.DocInfo, serverSeq uint64) {
+			assert.Nil(t, clientInfo.UpdateCheckpoint(docInfo.ID, checkpoint.New(serverSeq, 0)))
+			assert.Nil(t, cli.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
+		}
+
+		client1, docInfo := attach(t.Name() + "-1")
+		client2, _ := attach(t.Name() + "-2")
+		actor1 := time.ActorIDFromHex(client1.ID.Hex())
+		actor2 := time.ActorIDFromHex(client2.ID.Hex())
+
+		// 01. client1 pushes a change, then client2 pushes a change made
+		// offline before it, which has a smaller lamport.
+		c1 := change.New(change.NewID(1, 5, actor1), "", nil)
+		c1.SetServerSeq(1)
+		c2 := change.New(change.NewID(1, 2, actor2), "", nil)
+		c2.SetServerSeq(2)
+		assert.Nil(t, cli.CreateChangeInfos(ctx, docInfo.ID, []*change.Change{c1, c2}))
+		sync(client1, docInfo, 1)
+		sync(client2, docInfo, 2)
+
+		// 02. client1 has not pulled the change of client2, so the ticket
+		// should be before it.
+		ticket, err := cli.FindMinSyncedTicket(ctx, docInfo.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1), ticket.Lamport())
+		assert.True(t, c2.ID().NewTimeTicket(0).After(ticket))
+
+		// 03. once client1 pulls it, the ticket of the change client1 synced
+		// last is used.
+		sync(client1, docInfo, 2)
+		ticket, err = cli.FindMinSyncedTicket(ctx, docInfo.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(2), ticket.Lamport())
+	})
+}
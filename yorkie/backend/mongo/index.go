@@ -46,6 +46,21 @@ var (
 			{Key: "server_seq", Value: bsonx.Int32(1)},
 		},
 		Options: options.Index().SetUnique(true),
+	}, {
+		Keys: bsonx.Doc{
+			{Key: "doc_id", Value: bsonx.Int32(1)},
+			{Key: "actor", Value: bsonx.Int32(1)},
+			{Key: "server_seq", Value: bsonx.Int32(1)},
+		},
+	}}
+
+	ColSnapshots = "snapshots"
+	idxSnapshots = []mongo.IndexModel{{
+		Keys: bsonx.Doc{
+			{Key: "doc_id", Value: bsonx.Int32(1)},
+			{Key: "server_seq", Value: bsonx.Int32(1)},
+		},
+		Options: options.Index().SetUnique(true),
 	}}
 )
 
@@ -74,5 +89,13 @@ func ensureIndexes(ctx context.Context, db *mongo.Database) error {
 		return err
 	}
 
+	if _, err := db.Collection(ColSnapshots).Indexes().CreateMany(
+		ctx,
+		idxSnapshots,
+	); err != nil {
+		log.Logger.Error(err)
+		return err
+	}
+
 	return nil
 }
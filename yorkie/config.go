@@ -23,6 +23,7 @@ import (
 	"os"
 
 	"github.com/yorkie-team/yorkie/pkg/log"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
 	"github.com/yorkie-team/yorkie/yorkie/backend/mongo"
 )
 
@@ -30,12 +31,17 @@ const (
 	DefaultRPCPort        = 9090
 	DefaultMongoDBURI     = "mongodb://localhost:27017"
 	DefaultYorkieDatabase = "yorkie-meta"
+
+	DefaultSnapshotThreshold    = 500
+	DefaultSnapshotInterval     = 1000
+	DefaultMaxOperationsPerPack = 1000
 )
 
 // Config is the configuration for creating a Yorkie instance.
 type Config struct {
-	RPCPort int           `json:"RPCPort"`
-	Mongo   *mongo.Config `json:"Mongo"`
+	RPCPort int             `json:"RPCPort"`
+	Mongo   *mongo.Config   `json:"Mongo"`
+	Backend *backend.Config `json:"Backend"`
 }
 
 // RPCAddr returns the RPC address.
@@ -56,7 +62,9 @@ func NewConfigWithPortAndDBName(port int, dbname string) *Config {
 
 // NewConfigFromFile returns a Config struct for the given config file.
 func NewConfigFromFile(path string) (*Config, error) {
-	conf := &Config{}
+	conf := &Config{
+		Backend: newBackendConfig(),
+	}
 	file, err := os.Open(path)
 	if err != nil {
 		log.Logger.Error(err)
@@ -86,5 +94,14 @@ func newConfig(port int, dbname string) *Config {
 			PingTimeoutSec:       5,
 			YorkieDatabase:       dbname,
 		},
+		Backend: newBackendConfig(),
+	}
+}
+
+func newBackendConfig() *backend.Config {
+	return &backend.Config{
+		SnapshotThreshold:    DefaultSnapshotThreshold,
+		SnapshotInterval:     DefaultSnapshotInterval,
+		MaxOperationsPerPack: DefaultMaxOperationsPerPack,
 	}
 }
@@ -0,0 +1,172 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package documents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/checkpoint"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/log"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/types"
+)
+
+const (
+	// DefaultChangesLimit is the number of changes returned by ListChanges if
+	// the limit is not given.
+	DefaultChangesLimit = 100
+
+	// MaxChangesLimit is the maximum number of changes returned by ListChanges
+	// at once. Greater limits are clamped to it.
+	MaxChangesLimit = 1000
+)
+
+var (
+	// ErrServerSeqOutOfRange is returned when the given server seq is greater
+	// than the last server seq of the document.
+	ErrServerSeqOutOfRange = errors.New("server seq is out of range")
+
+	// ErrInvalidActorID is returned when the given actor ID can not be parsed.
+	ErrInvalidActorID = errors.New("invalid actor ID")
+)
+
+// FindDocInfo returns the document info of the given key.
+func FindDocInfo(
+	ctx context.Context,
+	be *backend.Backend,
+	docKey *key.Key,
+) (*types.DocInfo, error) {
+	return be.Mongo.FindDocInfoByBSONKey(ctx, docKey.BSONKey())
+}
+
+// Materialize builds the document as it was at the given server seq. It
+// starts from the closest snapshot before the server seq if there is one, and
+// applies the changes after it.
+func Materialize(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *types.DocInfo,
+	serverSeq uint64,
+) (*document.Document, error) {
+	if serverSeq > docInfo.ServerSeq {
+		return nil, fmt.Errorf("%w: %d > %d", ErrServerSeqOutOfRange, serverSeq, docInfo.ServerSeq)
+	}
+
+	docKey, err := key.FromBSONKey(docInfo.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	// 01. create the document from the closest snapshot.
+	snapshotInfo, err := be.Mongo.FindClosestSnapshotInfo(ctx, docInfo.ID, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := document.New(docKey.Collection, docKey.Document)
+	if len(snapshotInfo.Snapshot) > 0 {
+		snapshotPack, err := converter.BytesToSnapshot(snapshotInfo.Snapshot)
+		if err != nil {
+			return nil, err
+		}
+		doc = document.FromSnapshot(snapshotPack)
+	}
+
+	// 02. apply the changes between the snapshot and the given server seq.
+	if snapshotInfo.ServerSeq < serverSeq {
+		changes, err := be.Mongo.FindChangeInfosBetweenServerSeqs(
+			ctx,
+			docInfo.ID,
+			snapshotInfo.ServerSeq+1,
+			serverSeq,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := doc.ApplyChangePack(change.NewPack(
+			docKey,
+			checkpoint.Initial.NextServerSeq(serverSeq),
+			changes,
+		)); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Logger.Infof(
+		"MATERIALIZE: '%s', snapshot: %d, serverSeq: %d",
+		docInfo.Key,
+		snapshotInfo.ServerSeq,
+		serverSeq,
+	)
+
+	return doc, nil
+}
+
+// ListChanges returns at most the given number of changes of the document
+// from the given server seq. The number is clamped to MaxChangesLimit. If the
+// given actor ID is not empty, only the changes made by the actor are
+// returned. It also returns the server seq to continue from, which is 0 if
+// there are no more changes.
+func ListChanges(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *types.DocInfo,
+	from uint64,
+	limit int,
+	actorID string,
+) ([]*change.Change, uint64, error) {
+	if from == 0 {
+		from = 1
+	}
+	if limit <= 0 {
+		limit = DefaultChangesLimit
+	} else if limit > MaxChangesLimit {
+		limit = MaxChangesLimit
+	}
+
+	var actor *primitive.ObjectID
+	if actorID != "" {
+		id, err := primitive.ObjectIDFromHex(actorID)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%w: %s", ErrInvalidActorID, actorID)
+		}
+		actor = &id
+	}
+
+	// NOTE: One more change is fetched to know whether there are more.
+	changes, err := be.Mongo.FindChangeInfos(ctx, docInfo.ID, from, int64(limit+1), actor)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var next uint64
+	if len(changes) > limit {
+		next = changes[limit].ServerSeq()
+		changes = changes[:limit]
+	}
+
+	return changes, next, nil
+}
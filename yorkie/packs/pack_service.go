@@ -19,6 +19,8 @@ package packs
 import (
 	"context"
 
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/checkpoint"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
@@ -46,13 +48,29 @@ func PushPull(
 	initialServerSeq := docInfo.ServerSeq
 
 	// 01. push changes.
-	pushedCP, pushedChanges, err := pushChanges(clientInfo, docInfo, pack, initialServerSeq)
+	pushedCP, pushedChanges, pushedAll, err := pushChanges(
+		clientInfo,
+		docInfo,
+		pack,
+		initialServerSeq,
+		be.Config.MaxOperationsPerPack,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	// 02. pull changes.
-	pulledCP, pulledChanges, err := pullChanges(ctx, be, clientInfo, docInfo, pack, pushedCP, initialServerSeq)
+	// 02. pull changes. If the client is far behind, a snapshot is pulled
+	// together with the changes after it.
+	pulledCP, pulledChanges, snapshot, pulledAll, err := pullChanges(
+		ctx,
+		be,
+		clientInfo,
+		docInfo,
+		pack,
+		pushedCP,
+		initialServerSeq,
+		be.Config.MaxOperationsPerPack,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +92,18 @@ func PushPull(
 		return nil, err
 	}
 
-	// 04. publish document change event.
+	minSyncedTicket, err := be.Mongo.FindMinSyncedTicket(ctx, docInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 04. store the snapshot of the document if needed. The changes have
+	// been stored already, so a failure only leaves it to the next push.
+	if err := storeSnapshot(ctx, be, docInfo, initialServerSeq, pushedChanges); err != nil {
+		log.Logger.Error(err)
+	}
+
+	// 05. publish document change event.
 	if pack.HasChanges() {
 		be.Publish(
 			time.ActorIDFromHex(clientInfo.ID.Hex()),
@@ -91,25 +120,41 @@ func PushPull(
 		return nil, err
 	}
 
-	return change.NewPack(
+	pulledPack := change.NewPack(
 		docKey,
 		pulledCP,
 		pulledChanges,
-	), nil
+	)
+	pulledPack.MinSyncedTicket = minSyncedTicket
+	pulledPack.Snapshot = snapshot
+	pulledPack.Partial = !pushedAll || !pulledAll
+
+	return pulledPack, nil
 }
 
-// pushChanges returns the changes excluding already saved in MongoDB.
+// pushChanges returns the changes excluding already saved in MongoDB. The
+// changes having at most the given maxOps operations are pushed, and it returns
+// false if the others are left to be pushed again.
 func pushChanges(
 	clientInfo *types.ClientInfo,
 	docInfo *types.DocInfo,
 	pack *change.Pack,
 	initialServerSeq uint64,
-) (*checkpoint.Checkpoint, []*change.Change, error) {
+	maxOps uint64,
+) (*checkpoint.Checkpoint, []*change.Change, bool, error) {
 	cp := clientInfo.GetCheckpoint(docInfo.ID)
 
 	var pushedChanges []*change.Change
+	pushedAll := true
+	pushedOps := uint64(0)
 	for _, c := range pack.Changes {
 		if c.ID().ClientSeq() > cp.ClientSeq {
+			pushedOps += uint64(len(c.Operations()))
+			if maxOps > 0 && len(pushedChanges) > 0 && pushedOps > maxOps {
+				pushedAll = false
+				break
+			}
+
 			serverSeq := docInfo.IncreaseServerSeq()
 			cp = cp.NextServerSeq(serverSeq)
 			c.SetServerSeq(serverSeq)
@@ -134,9 +179,14 @@ func pushChanges(
 		)
 	}
 
-	return cp, pushedChanges, nil
+	return cp, pushedChanges, pushedAll, nil
 }
 
+// pullChanges returns the changes after the checkpoint of the given pack. The
+// changes having at most the given maxOps operations are pulled, and it
+// returns false if there
+// are more changes to pull. The returned checkpoint only covers the pulled
+// changes so that the client can continue from it.
 func pullChanges(
 	ctx context.Context,
 	be *backend.Backend,
@@ -145,20 +195,50 @@ func pullChanges(
 	pack *change.Pack,
 	pushedCP *checkpoint.Checkpoint,
 	initialServerSeq uint64,
-) (*checkpoint.Checkpoint, []*change.Change, error) {
-	fetchedChanges, err := be.Mongo.FindChangeInfosBetweenServerSeqs(
-		ctx,
-		docInfo.ID,
-		pack.Checkpoint.ServerSeq+1,
-		initialServerSeq,
-	)
+	maxOps uint64,
+) (*checkpoint.Checkpoint, []*change.Change, *change.Snapshot, bool, error) {
+	from := pack.Checkpoint.ServerSeq + 1
+
+	// If the client is far behind, send the last snapshot instead of the
+	// changes before it. Because the client replaces its root with the
+	// snapshot, its own changes after the snapshot should also be sent.
+	var snapshot *change.Snapshot
+	if isFarBehind(be.Config.SnapshotThreshold, initialServerSeq, pack.Checkpoint.ServerSeq) {
+		lastSnapshot, snapshotSeq, err := findSnapshotAfter(ctx, be, docInfo, pack.Checkpoint.ServerSeq, initialServerSeq)
+		if err != nil {
+			return nil, nil, nil, false, err
+		}
+		if lastSnapshot != nil {
+			snapshot = lastSnapshot
+			from = snapshotSeq + 1
+		}
+	}
+
+	fetchedChanges, to, pulledAll, err := findChanges(ctx, be, docInfo, from, initialServerSeq, maxOps)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, false, err
+	}
+
+	// NOTE: Clients batch their local changes into a change, so a few changes
+	// can have many operations. If so, the snapshot is sent as well.
+	if snapshot == nil && countOperations(fetchedChanges) >= be.Config.SnapshotThreshold {
+		lastSnapshot, snapshotSeq, err := findSnapshotAfter(ctx, be, docInfo, pack.Checkpoint.ServerSeq, initialServerSeq)
+		if err != nil {
+			return nil, nil, nil, false, err
+		}
+		if lastSnapshot != nil {
+			snapshot = lastSnapshot
+			from = snapshotSeq + 1
+			fetchedChanges, to, pulledAll, err = findChanges(ctx, be, docInfo, from, initialServerSeq, maxOps)
+			if err != nil {
+				return nil, nil, nil, false, err
+			}
+		}
 	}
 
 	var pulledChanges []*change.Change
 	for _, fetchedChange := range fetchedChanges {
-		if fetchedChange.ID().Actor().String() == clientInfo.ID.Hex() {
+		if snapshot == nil && fetchedChange.ID().Actor().String() == clientInfo.ID.Hex() {
 			continue
 		}
 
@@ -166,6 +246,9 @@ func pullChanges(
 	}
 
 	pulledCP := pushedCP.NextServerSeq(docInfo.ServerSeq)
+	if !pulledAll {
+		pulledCP = checkpoint.New(to, pushedCP.ClientSeq)
+	}
 
 	if len(pulledChanges) > 0 {
 		log.Logger.Infof(
@@ -179,5 +262,206 @@ func pullChanges(
 		)
 	}
 
-	return pulledCP, pulledChanges, nil
+	if snapshot != nil {
+		log.Logger.Infof(
+			"PULL: '%s' pulls snapshot(%d) from '%s', cp: %s",
+			clientInfo.ID.Hex(),
+			from-1,
+			docInfo.Key,
+			pulledCP.String(),
+		)
+	}
+
+	return pulledCP, pulledChanges, snapshot, pulledAll, nil
+}
+
+// findSnapshotAfter returns the last snapshot of the document and its server
+// seq if it has been taken after the given server seq. It returns nil if there
+// is no such snapshot.
+func findSnapshotAfter(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *types.DocInfo,
+	serverSeq uint64,
+	initialServerSeq uint64,
+) (*change.Snapshot, uint64, error) {
+	snapshotInfo, err := be.Mongo.FindLastSnapshotInfo(ctx, docInfo.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if snapshotInfo.ServerSeq <= serverSeq || snapshotInfo.ServerSeq > initialServerSeq {
+		return nil, 0, nil
+	}
+
+	snapshotPack, err := converter.BytesToSnapshot(snapshotInfo.Snapshot)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return snapshotPack.Snapshot, snapshotInfo.ServerSeq, nil
+}
+
+// findChanges returns the changes between the given server seqs. The changes
+// having at most the given maxOps operations are returned, and it returns the
+// server seq of the last one and false if there are more changes.
+func findChanges(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *types.DocInfo,
+	from uint64,
+	to uint64,
+	maxOps uint64,
+) ([]*change.Change, uint64, bool, error) {
+	// NOTE: A change has at least one operation, so no more than maxOps
+	// changes are needed to fill the pack.
+	foundAll := true
+	if maxOps > 0 && to >= from && to-from+1 > maxOps {
+		to = from + maxOps - 1
+		foundAll = false
+	}
+
+	changes, err := be.Mongo.FindChangeInfosBetweenServerSeqs(
+		ctx,
+		docInfo.ID,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	if maxOps > 0 {
+		ops := uint64(0)
+		for i, c := range changes {
+			ops += uint64(len(c.Operations()))
+			if i > 0 && ops > maxOps {
+				changes = changes[:i]
+				to = c.ServerSeq() - 1
+				foundAll = false
+				break
+			}
+		}
+	}
+
+	return changes, to, foundAll, nil
+}
+
+// countOperations returns the number of operations in the given changes.
+func countOperations(changes []*change.Change) uint64 {
+	count := uint64(0)
+	for _, c := range changes {
+		count += uint64(len(c.Operations()))
+	}
+	return count
+}
+
+// isFarBehind returns whether a client of the given server seq is behind the
+// document of the given server seq by the threshold or more, so that the
+// snapshot should be sent instead of the changes before it.
+func isFarBehind(threshold, docServerSeq, clientServerSeq uint64) bool {
+	return docServerSeq > clientServerSeq && docServerSeq-clientServerSeq >= threshold
+}
+
+// crossesSnapshotInterval returns whether a snapshot should be stored after
+// the server seq has increased from the given initialServerSeq to the given
+// serverSeq by the changes having the given number of operations.
+func crossesSnapshotInterval(interval, initialServerSeq, serverSeq, ops uint64) bool {
+	if serverSeq == initialServerSeq {
+		return false
+	}
+	if interval == 0 {
+		return true
+	}
+
+	return initialServerSeq/interval != serverSeq/interval || ops >= interval
+}
+
+// storeSnapshot stores the snapshot of the document if the server seq of the
+// document crosses a multiple of the snapshot interval by the pushed changes,
+// or if the pushed changes have operations more than the interval.
+func storeSnapshot(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *types.DocInfo,
+	initialServerSeq uint64,
+	pushedChanges []*change.Change,
+) error {
+	// 01. get the last snapshot of the document only if the interval is
+	// crossed, so that most of the pushes do not query it. Clients batch
+	// their local changes into a change, so the operations are also counted.
+	if !crossesSnapshotInterval(
+		be.Config.SnapshotInterval,
+		initialServerSeq,
+		docInfo.ServerSeq,
+		countOperations(pushedChanges),
+	) {
+		return nil
+	}
+
+	snapshotInfo, err := be.Mongo.FindLastSnapshotInfo(ctx, docInfo.ID)
+	if err != nil {
+		return err
+	}
+
+	if snapshotInfo.ServerSeq >= docInfo.ServerSeq {
+		return nil
+	}
+
+	// 02. retrieve the changes between the last snapshot and the current.
+	changes, err := be.Mongo.FindChangeInfosBetweenServerSeqs(
+		ctx,
+		docInfo.ID,
+		snapshotInfo.ServerSeq+1,
+		docInfo.ServerSeq,
+	)
+	if err != nil {
+		return err
+	}
+
+	// 03. create the document from the last snapshot and apply the changes.
+	docKey, err := key.FromBSONKey(docInfo.Key)
+	if err != nil {
+		return err
+	}
+
+	doc := document.New(docKey.Collection, docKey.Document)
+	if len(snapshotInfo.Snapshot) > 0 {
+		snapshotPack, err := converter.BytesToSnapshot(snapshotInfo.Snapshot)
+		if err != nil {
+			return err
+		}
+		doc = document.FromSnapshot(snapshotPack)
+	}
+
+	if err := doc.ApplyChangePack(change.NewPack(
+		docKey,
+		checkpoint.Initial.NextServerSeq(docInfo.ServerSeq),
+		changes,
+	)); err != nil {
+		return err
+	}
+
+	// 04. save the snapshot of the document.
+	snapshot, err := converter.SnapshotToBytes(doc.Snapshot())
+	if err != nil {
+		return err
+	}
+
+	if err := be.Mongo.CreateSnapshotInfo(
+		ctx,
+		docInfo.ID,
+		doc.Checkpoint().ServerSeq,
+		snapshot,
+	); err != nil {
+		return err
+	}
+
+	log.Logger.Infof(
+		"SNAPSHOT: '%s', serverSeq: %d",
+		docInfo.Key,
+		docInfo.ServerSeq,
+	)
+
+	return nil
 }
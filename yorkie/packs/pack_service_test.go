@@ -0,0 +1,52 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotConditions(t *testing.T) {
+	t.Run("far behind test", func(t *testing.T) {
+		assert.False(t, isFarBehind(10, 10, 10))
+		assert.False(t, isFarBehind(10, 19, 10))
+		assert.True(t, isFarBehind(10, 20, 10))
+
+		// a client pushing changes is ahead of the document before the push.
+		assert.False(t, isFarBehind(10, 10, 30))
+	})
+
+	t.Run("crosses snapshot interval test", func(t *testing.T) {
+		// nothing has been pushed.
+		assert.False(t, crossesSnapshotInterval(10, 15, 15, 0))
+
+		// the server seq stays within the interval.
+		assert.False(t, crossesSnapshotInterval(10, 11, 15, 4))
+
+		// the server seq crosses a multiple of the interval.
+		assert.True(t, crossesSnapshotInterval(10, 9, 10, 1))
+		assert.True(t, crossesSnapshotInterval(10, 15, 21, 6))
+
+		// a few changes have operations as many as the interval.
+		assert.True(t, crossesSnapshotInterval(10, 11, 12, 10))
+
+		// a snapshot is stored on every push without an interval.
+		assert.True(t, crossesSnapshotInterval(0, 11, 12, 1))
+	})
+}
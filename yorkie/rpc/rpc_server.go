@@ -18,6 +18,7 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 
@@ -34,6 +35,7 @@ import (
 	"github.com/yorkie-team/yorkie/yorkie/backend"
 	"github.com/yorkie-team/yorkie/yorkie/backend/mongo"
 	"github.com/yorkie-team/yorkie/yorkie/clients"
+	"github.com/yorkie-team/yorkie/yorkie/documents"
 	"github.com/yorkie-team/yorkie/yorkie/packs"
 	"github.com/yorkie-team/yorkie/yorkie/types"
 )
@@ -172,8 +174,13 @@ func (s *Server) AttachDocument(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	pbPack, err := converter.ToChangePack(pulled)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &api.AttachDocumentResponse{
-		ChangePack: converter.ToChangePack(pulled),
+		ChangePack: pbPack,
 	}, nil
 }
 
@@ -219,8 +226,13 @@ func (s *Server) DetachDocument(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	pbPack, err := converter.ToChangePack(pulled)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &api.DetachDocumentResponse{
-		ChangePack: converter.ToChangePack(pulled),
+		ChangePack: pbPack,
 	}, nil
 }
 
@@ -264,8 +276,109 @@ func (s *Server) PushPull(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	pbPack, err := converter.ToChangePack(pulled)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &api.PushPullResponse{
-		ChangePack: converter.ToChangePack(pulled),
+		ChangePack: pbPack,
+	}, nil
+}
+
+// GetDocument returns the current JSON of the document built from the stored
+// snapshot and changes.
+func (s *Server) GetDocument(
+	ctx context.Context,
+	req *api.GetDocumentRequest,
+) (*api.GetDocumentResponse, error) {
+	docKey, docInfo, err := s.findDocInfoByKey(ctx, req.DocumentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := documents.Materialize(ctx, s.backend, docInfo, docInfo.ServerSeq)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &api.GetDocumentResponse{
+		DocumentKey: converter.ToDocumentKey(docKey),
+		ServerSeq:   docInfo.ServerSeq,
+		Json:        doc.Marshal(),
+	}, nil
+}
+
+// GetDocumentAt returns the JSON of the document as it was at the given
+// server seq.
+func (s *Server) GetDocumentAt(
+	ctx context.Context,
+	req *api.GetDocumentAtRequest,
+) (*api.GetDocumentAtResponse, error) {
+	docKey, docInfo, err := s.findDocInfoByKey(ctx, req.DocumentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := documents.Materialize(ctx, s.backend, docInfo, req.ServerSeq)
+	if err != nil {
+		if errors.Is(err, documents.ErrServerSeqOutOfRange) {
+			return nil, toStatusError(
+				codes.InvalidArgument,
+				err.Error(),
+				[]fieldViolation{{
+					field:       "server_seq",
+					description: "the server seq must not be greater than the last server seq",
+				}},
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &api.GetDocumentAtResponse{
+		DocumentKey: converter.ToDocumentKey(docKey),
+		ServerSeq:   req.ServerSeq,
+		Json:        doc.Marshal(),
+	}, nil
+}
+
+// ListChanges returns the summaries of the changes of the document from the
+// given server seq.
+func (s *Server) ListChanges(
+	ctx context.Context,
+	req *api.ListChangesRequest,
+) (*api.ListChangesResponse, error) {
+	docKey, docInfo, err := s.findDocInfoByKey(ctx, req.DocumentKey)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, next, err := documents.ListChanges(
+		ctx,
+		s.backend,
+		docInfo,
+		req.FromServerSeq,
+		int(req.Limit),
+		req.ActorId,
+	)
+	if err != nil {
+		if errors.Is(err, documents.ErrInvalidActorID) {
+			return nil, toStatusError(
+				codes.InvalidArgument,
+				err.Error(),
+				[]fieldViolation{{
+					field:       "actor_id",
+					description: "the actor ID must be a hex string of 12 bytes",
+				}},
+			)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &api.ListChangesResponse{
+		DocumentKey:   converter.ToDocumentKey(docKey),
+		Changes:       converter.ToChangeSummaries(changes),
+		NextServerSeq: next,
 	}, nil
 }
 
@@ -330,6 +443,35 @@ func (s *Server) listenAndServeGRPC() error {
 	return nil
 }
 
+// findDocInfoByKey returns the document info of the given document key. The
+// returned error is a status error to be returned by the handlers.
+func (s *Server) findDocInfoByKey(
+	ctx context.Context,
+	pbDocKey *api.DocumentKey,
+) (*key.Key, *types.DocInfo, error) {
+	if pbDocKey == nil {
+		return nil, nil, toStatusError(
+			codes.InvalidArgument,
+			"invalid document key",
+			[]fieldViolation{{
+				field:       "document_key",
+				description: "the document key must not be empty",
+			}},
+		)
+	}
+
+	docKey := converter.FromDocumentKey(pbDocKey)
+	docInfo, err := documents.FindDocInfo(ctx, s.backend, docKey)
+	if err != nil {
+		if err == mongo.ErrDocumentNotFound {
+			return nil, nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return docKey, docInfo, nil
+}
+
 func toStatusError(code codes.Code, msg string, violations []fieldViolation) error {
 	br := &errdetails.BadRequest{}
 
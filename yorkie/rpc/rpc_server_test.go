@@ -209,7 +209,10 @@ func withRPCServer(
 	t *testing.T,
 	f func(t *testing.T, rpcServer *rpc.Server),
 ) {
-	be, err := backend.New(&mongo.Config{
+	be, err := backend.New(&backend.Config{
+		SnapshotThreshold: testhelper.TestSnapshotThreshold,
+		SnapshotInterval:  testhelper.TestSnapshotInterval,
+	}, &mongo.Config{
 		ConnectionURI:        testhelper.TestMongoConnectionURI,
 		YorkieDatabase:       testhelper.TestDBName(),
 		ConnectionTimeoutSec: 5,
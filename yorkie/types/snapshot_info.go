@@ -0,0 +1,32 @@
+/*
+ * Copyright 2020 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SnapshotInfo is a structure representing information of the snapshot.
+type SnapshotInfo struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	DocID     primitive.ObjectID `bson:"doc_id"`
+	ServerSeq uint64             `bson:"server_seq"`
+	Snapshot  []byte             `bson:"snapshot"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
@@ -39,7 +39,7 @@ type Yorkie struct {
 
 // New creates a new instance of Yorkie.
 func New(conf *Config) (*Yorkie, error) {
-	be, err := backend.New(conf.Mongo)
+	be, err := backend.New(conf.Backend, conf.Mongo)
 	if err != nil {
 		return nil, err
 	}